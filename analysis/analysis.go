@@ -0,0 +1,198 @@
+// Package analysis measures how the runs of many cron schedules are spread
+// over the clock, to find overloaded minutes and hours.
+//
+// Analyze counts the runs of every schedule in a time window and buckets them
+// by minute of the hour, hour of the day and day of the week, all in a single
+// reporting location:
+//
+//	load := analysis.Analyze(schedulers, from, from.AddDate(0, 1, 0))
+//	for _, b := range load.Peaks(5) {
+//	    fmt.Printf("%02d:%02d %d runs\n", b.Hour, b.Minute, b.Count)
+//	}
+//
+// Suggest then proposes new minutes for jobs that run at a single minute, so
+// that the busiest buckets are flattened:
+//
+//	for _, s := range load.Suggest() {
+//	    fmt.Printf("job %d: %s -> %s\n", s.Index, s.Expression, s.Suggested)
+//	}
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/SravanKolanu20/expressparser"
+)
+
+// minutesPerDay is the number of hour-and-minute buckets in a day
+const minutesPerDay = 24 * 60
+
+// Load is the distribution of runs of a set of schedules over a window
+//
+// Runs are counted at minute resolution: a schedule with several seconds in
+// a minute contributes that many runs to the minute's bucket.
+type Load struct {
+	From     time.Time      // Start of the window, inclusive
+	To       time.Time      // End of the window, exclusive
+	Location *time.Location // Location the buckets are computed in
+
+	Total    int         // Runs of all schedules in the window
+	Minutes  [60]int     // Runs per minute of the hour
+	Hours    [24]int     // Runs per hour of the day
+	Weekdays [7]int      // Runs per day of the week, indexed by time.Weekday
+	Heatmap  [24][60]int // Runs per hour and minute of the day
+
+	jobs []*jobLoad
+}
+
+// jobLoad is the contribution of one schedule to the load
+type jobLoad struct {
+	scheduler *expressparser.Scheduler
+	total     int
+	cells     [minutesPerDay]int // Runs per hour*60+minute in the reporting location
+	weekdays  [7]int
+}
+
+// Bucket is a single hour and minute of the day with its run count
+type Bucket struct {
+	Hour   int
+	Minute int
+	Count  int
+}
+
+type analyzer struct {
+	location *time.Location
+}
+
+// Option configures Analyze
+type Option func(*analyzer)
+
+// WithLocation sets the location runs are bucketed in (default UTC)
+func WithLocation(loc *time.Location) Option {
+	return func(a *analyzer) {
+		a.location = loc
+	}
+}
+
+// Analyze counts the runs of each scheduler in [from, to)
+//
+// Schedules are evaluated in their own locations and bucketed in the
+// reporting location. Runs are derived from the matching days and the hour
+// and minute fields rather than by stepping through every occurrence, so
+// long windows and thousands of schedules are cheap.
+func Analyze(schedulers []*expressparser.Scheduler, from, to time.Time, opts ...Option) *Load {
+	a := &analyzer{location: time.UTC}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	load := &Load{From: from, To: to, Location: a.location}
+	for _, s := range schedulers {
+		job := a.count(s, from, to)
+		load.jobs = append(load.jobs, job)
+		load.Total += job.total
+		for cell, n := range job.cells {
+			load.Heatmap[cell/60][cell%60] += n
+		}
+		for d, n := range job.weekdays {
+			load.Weekdays[d] += n
+		}
+	}
+
+	for h := range load.Heatmap {
+		for m, n := range load.Heatmap[h] {
+			load.Hours[h] += n
+			load.Minutes[m] += n
+		}
+	}
+	return load
+}
+
+// AnalyzeExpressions is Analyze for expressions, evaluated in the reporting
+// location
+func AnalyzeExpressions(exprs []*expressparser.Expression, from, to time.Time, opts ...Option) *Load {
+	a := &analyzer{location: time.UTC}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	schedulers := make([]*expressparser.Scheduler, len(exprs))
+	for i, e := range exprs {
+		schedulers[i] = expressparser.NewScheduler(e, expressparser.WithLocation(a.location))
+	}
+	return Analyze(schedulers, from, to, opts...)
+}
+
+// count computes the runs of s per hour and minute of the reporting location
+func (a *analyzer) count(s *expressparser.Scheduler, from, to time.Time) *jobLoad {
+	job := &jobLoad{scheduler: s}
+	a.eachRunMinute(s, from, to, func(t time.Time, runs int) {
+		local := t.In(a.location)
+		job.cells[local.Hour()*60+local.Minute()] += runs
+		job.weekdays[local.Weekday()] += runs
+		job.total += runs
+	})
+	return job
+}
+
+// eachRunMinute calls fn with the start of every minute in [from, to) in
+// which s runs, along with the number of runs in that minute
+func (a *analyzer) eachRunMinute(s *expressparser.Scheduler, from, to time.Time, fn func(t time.Time, runs int)) {
+	expr := s.Expression()
+	loc := s.Location()
+	hours := expr.GetHours()
+	minutes := expr.GetMinutes()
+	perMinute := len(expr.GetSeconds())
+
+	first := from.In(loc)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !s.MatchesDate(day) {
+			continue
+		}
+		for _, h := range hours {
+			start := time.Date(day.Year(), day.Month(), day.Day(), h, 0, 0, 0, loc)
+			if start.Hour() != h {
+				continue // skipped by a daylight saving transition
+			}
+			for _, m := range minutes {
+				t := start.Add(time.Duration(m) * time.Minute)
+				if t.Before(from.Truncate(time.Minute)) || !t.Before(to) {
+					continue
+				}
+				fn(t, perMinute)
+			}
+		}
+	}
+}
+
+// Peaks returns the n busiest hour-and-minute buckets, busiest first, with
+// ties in time order
+func (l *Load) Peaks(n int) []Bucket {
+	var buckets []Bucket
+	for h := range l.Heatmap {
+		for m, count := range l.Heatmap[h] {
+			if count > 0 {
+				buckets = append(buckets, Bucket{Hour: h, Minute: m, Count: count})
+			}
+		}
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		return buckets[i].Count > buckets[j].Count
+	})
+	if n >= 0 && len(buckets) > n {
+		buckets = buckets[:n]
+	}
+	return buckets
+}
+
+// Peak returns the largest run count of any hour-and-minute bucket
+func (l *Load) Peak() int {
+	peak := 0
+	for h := range l.Heatmap {
+		for _, count := range l.Heatmap[h] {
+			peak = max(peak, count)
+		}
+	}
+	return peak
+}
@@ -0,0 +1,108 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SravanKolanu20/expressparser"
+)
+
+func parseAll(t *testing.T, exprs ...string) []*expressparser.Expression {
+	t.Helper()
+	result := make([]*expressparser.Expression, len(exprs))
+	for i, s := range exprs {
+		e, err := expressparser.Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", s, err)
+		}
+		result[i] = e
+	}
+	return result
+}
+
+func TestAnalyze_Histograms(t *testing.T) {
+	exprs := parseAll(t, "0 * * * *", "*/15 9-17 * * 1-5", "30 0 9 * * *")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) // Monday
+	load := AnalyzeExpressions(exprs, from, from.AddDate(0, 0, 7))
+
+	// 24*7 hourly, 9 hours * 4 * 5 weekdays, 7 daily
+	if want := 168 + 180 + 7; load.Total != want {
+		t.Errorf("Total = %d, want %d", load.Total, want)
+	}
+	if want := 168 + 45 + 7; load.Minutes[0] != want {
+		t.Errorf("Minutes[0] = %d, want %d", load.Minutes[0], want)
+	}
+	if load.Minutes[15] != 45 || load.Minutes[1] != 0 {
+		t.Errorf("Minutes[15] = %d, Minutes[1] = %d", load.Minutes[15], load.Minutes[1])
+	}
+	if want := 7 + 20 + 7; load.Hours[9] != want {
+		t.Errorf("Hours[9] = %d, want %d", load.Hours[9], want)
+	}
+	if load.Hours[3] != 7 {
+		t.Errorf("Hours[3] = %d, want 7", load.Hours[3])
+	}
+	if want := 24 + 36 + 1; load.Weekdays[time.Monday] != want {
+		t.Errorf("Weekdays[Monday] = %d, want %d", load.Weekdays[time.Monday], want)
+	}
+	if load.Weekdays[time.Sunday] != 25 {
+		t.Errorf("Weekdays[Sunday] = %d, want 25", load.Weekdays[time.Sunday])
+	}
+	if load.Heatmap[9][0] != 7+5+7 {
+		t.Errorf("Heatmap[9][0] = %d, want 19", load.Heatmap[9][0])
+	}
+}
+
+func TestAnalyze_WindowBounds(t *testing.T) {
+	exprs := parseAll(t, "*/10 * * * *")
+	from := time.Date(2024, 1, 31, 23, 25, 0, 0, time.UTC)
+	load := AnalyzeExpressions(exprs, from, from.Add(time.Hour))
+
+	// 23:30, 23:40, 23:50, 00:00, 00:10, 00:20 across the month boundary
+	if load.Total != 6 {
+		t.Errorf("Total = %d, want 6", load.Total)
+	}
+	if load.Weekdays[time.Thursday] != 3 {
+		t.Errorf("Weekdays[Thursday] = %d, want 3", load.Weekdays[time.Thursday])
+	}
+}
+
+func TestAnalyze_Locations(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedulers := []*expressparser.Scheduler{
+		expressparser.NewScheduler(expressparser.MustParse("0 9 * * *"), expressparser.WithLocation(ny)),
+	}
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	load := Analyze(schedulers, from, from.AddDate(0, 0, 10))
+
+	if load.Hours[14] != 10 {
+		t.Errorf("Hours[14] = %d, want 10 (9:00 EST in UTC)", load.Hours[14])
+	}
+
+	load = Analyze(schedulers, from, from.AddDate(0, 0, 10), WithLocation(ny))
+	if load.Hours[9] != 10 {
+		t.Errorf("Hours[9] = %d, want 10 in New York", load.Hours[9])
+	}
+}
+
+func TestLoad_Peaks(t *testing.T) {
+	exprs := parseAll(t, "0 9 * * *", "0 9 * * *", "0 9 * * *", "5 9 * * *", "0 12 * * *")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	load := AnalyzeExpressions(exprs, from, from.AddDate(0, 0, 1))
+
+	peaks := load.Peaks(2)
+	if len(peaks) != 2 {
+		t.Fatalf("len(Peaks) = %d, want 2", len(peaks))
+	}
+	if peaks[0] != (Bucket{Hour: 9, Minute: 0, Count: 3}) {
+		t.Errorf("Peaks[0] = %+v", peaks[0])
+	}
+	if peaks[1] != (Bucket{Hour: 9, Minute: 5, Count: 1}) {
+		t.Errorf("Peaks[1] = %+v, want 9:05 before 12:00", peaks[1])
+	}
+	if load.Peak() != 3 {
+		t.Errorf("Peak() = %d, want 3", load.Peak())
+	}
+}
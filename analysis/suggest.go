@@ -0,0 +1,152 @@
+package analysis
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SravanKolanu20/expressparser"
+)
+
+// Suggestion proposes moving a job to another minute of the hour
+type Suggestion struct {
+	Index      int                       // Index of the job's scheduler in the analyzed slice
+	Expression *expressparser.Expression // Current expression
+	Suggested  *expressparser.Expression // Expression with the minute replaced
+	Shift      int                       // Minutes the runs move by, negative for earlier
+}
+
+type suggester struct {
+	maxShift int
+}
+
+// SuggestOption configures Suggest
+type SuggestOption func(*suggester)
+
+// WithMaxShift limits how many minutes a job may be moved in either
+// direction (default 59, anywhere in the same hour)
+func WithMaxShift(minutes int) SuggestOption {
+	return func(s *suggester) {
+		s.maxShift = minutes
+	}
+}
+
+// Suggest proposes new minutes for jobs so that the busiest hour-and-minute
+// buckets are flattened
+//
+// Only jobs whose minute field is a single value are moved, and they stay in
+// the same hour, so "0 9 * * *" may become "7 9 * * *" but "*/15 * * * *" is
+// left alone. Jobs are placed greedily, largest first, at the minute that
+// minimises the busiest bucket they touch; ties keep the smallest shift.
+// Suggestions are returned in job order and only for jobs that move.
+func (l *Load) Suggest(opts ...SuggestOption) []Suggestion {
+	s := &suggester{maxShift: 59}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	var grid [minutesPerDay]int
+	for h := range l.Heatmap {
+		for m, n := range l.Heatmap[h] {
+			grid[h*60+m] = n
+		}
+	}
+
+	var movable []int
+	for i, job := range l.jobs {
+		if job.total > 0 && len(job.scheduler.Expression().GetMinutes()) == 1 {
+			movable = append(movable, i)
+		}
+	}
+	sort.SliceStable(movable, func(a, b int) bool {
+		return l.jobs[movable[a]].total > l.jobs[movable[b]].total
+	})
+
+	var suggestions []Suggestion
+	for _, i := range movable {
+		job := l.jobs[i]
+		expr := job.scheduler.Expression()
+		minute := expr.GetMinutes()[0]
+
+		job.place(&grid, 0, -1)
+		best, bestPeak, bestSpread := 0, 0, 0
+		first := true
+		for m := max(0, minute-s.maxShift); m <= min(59, minute+s.maxShift); m++ {
+			shift := m - minute
+			peak, spread := job.cost(&grid, shift)
+			better := peak < bestPeak ||
+				(peak == bestPeak && (spread < bestSpread || (spread == bestSpread && abs(shift) < abs(best))))
+			if first || better {
+				best, bestPeak, bestSpread = shift, peak, spread
+				first = false
+			}
+		}
+		job.place(&grid, best, 1)
+
+		if best == 0 {
+			continue
+		}
+		suggested, err := withMinute(expr, minute+best)
+		if err != nil {
+			continue
+		}
+		suggestions = append(suggestions, Suggestion{
+			Index:      i,
+			Expression: expr,
+			Suggested:  suggested,
+			Shift:      best,
+		})
+	}
+
+	sort.Slice(suggestions, func(a, b int) bool {
+		return suggestions[a].Index < suggestions[b].Index
+	})
+	return suggestions
+}
+
+// place adds (sign 1) or removes (sign -1) the job's runs, shifted by shift
+// minutes, to grid
+func (j *jobLoad) place(grid *[minutesPerDay]int, shift, sign int) {
+	for cell, n := range j.cells {
+		if n > 0 {
+			grid[shiftCell(cell, shift)] += sign * n
+		}
+	}
+}
+
+// cost returns the busiest bucket the job would touch if shifted by shift
+// minutes, and the growth of the sum of squared bucket counts as a measure of
+// how unevenly the load would be spread
+func (j *jobLoad) cost(grid *[minutesPerDay]int, shift int) (peak, spread int) {
+	for cell, n := range j.cells {
+		if n == 0 {
+			continue
+		}
+		current := grid[shiftCell(cell, shift)]
+		peak = max(peak, current+n)
+		spread += (current+n)*(current+n) - current*current
+	}
+	return peak, spread
+}
+
+func shiftCell(cell, shift int) int {
+	return ((cell+shift)%minutesPerDay + minutesPerDay) % minutesPerDay
+}
+
+// withMinute returns expr with its minute field set to minute
+func withMinute(expr *expressparser.Expression, minute int) (*expressparser.Expression, error) {
+	fields := expr.FieldStrings()
+	idx := 0
+	if expr.IsExtended() {
+		idx = 1
+	}
+	fields[idx] = strconv.Itoa(minute)
+	return expressparser.Parse(strings.Join(fields, " "))
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
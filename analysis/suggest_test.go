@@ -0,0 +1,70 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoad_Suggest(t *testing.T) {
+	exprs := parseAll(t, "0 9 * * *", "0 9 * * *", "0 9 * * *", "0 9 * * *", "*/30 * * * *")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	load := AnalyzeExpressions(exprs, from, from.AddDate(0, 0, 7))
+
+	// The */30 job already runs at 9:00, so every 9:00 job moves
+	suggestions := load.Suggest()
+	if len(suggestions) != 4 {
+		t.Fatalf("got %d suggestions, want 4: %+v", len(suggestions), suggestions)
+	}
+
+	seen := make(map[string]bool)
+	for _, s := range suggestions {
+		if s.Index == 4 {
+			t.Errorf("suggested moving the */30 job")
+		}
+		if s.Shift == 0 || s.Shift == 30 {
+			t.Errorf("suggestion %+v does not avoid existing runs", s)
+		}
+		got := s.Suggested.String()
+		if seen[got] {
+			t.Errorf("two jobs moved to %q", got)
+		}
+		seen[got] = true
+	}
+
+	// Apply the suggestions and check the peak went down
+	moved := append(exprs[:0:0], exprs...)
+	for _, s := range suggestions {
+		moved[s.Index] = s.Suggested
+	}
+	after := AnalyzeExpressions(moved, from, from.AddDate(0, 0, 7))
+	if after.Peak() >= load.Peak() {
+		t.Errorf("peak after = %d, before = %d", after.Peak(), load.Peak())
+	}
+	if after.Total != load.Total {
+		t.Errorf("total changed from %d to %d", load.Total, after.Total)
+	}
+}
+
+func TestLoad_SuggestMaxShift(t *testing.T) {
+	exprs := parseAll(t, "10 9 * * *", "10 9 * * *", "0 8 * * * ")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	load := AnalyzeExpressions(exprs, from, from.AddDate(0, 0, 1))
+
+	suggestions := load.Suggest(WithMaxShift(2))
+	if len(suggestions) != 1 {
+		t.Fatalf("got %d suggestions, want 1", len(suggestions))
+	}
+	if s := suggestions[0]; s.Shift != -1 || s.Suggested.String() != "9 9 * * *" {
+		t.Errorf("suggestion = shift %d to %q, want -1 to \"9 9 * * *\"", s.Shift, s.Suggested.String())
+	}
+}
+
+func TestLoad_SuggestBalanced(t *testing.T) {
+	exprs := parseAll(t, "0 9 * * *", "30 9 * * *")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	load := AnalyzeExpressions(exprs, from, from.AddDate(0, 0, 1))
+
+	if s := load.Suggest(); len(s) != 0 {
+		t.Errorf("Suggest() = %+v, want nothing for a flat load", s)
+	}
+}
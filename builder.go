@@ -0,0 +1,323 @@
+// builder.go - Fluent construction of cron expressions
+
+package expressparser
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Builder constructs an Expression field by field
+//
+// Each call adds a part to its field, so calls on the same field combine
+// into a list: OnWeekdays().OnLast(time.Saturday) builds "1-5,6L". Fields
+// left unset match every value. Using any seconds method makes the
+// expression six fields long.
+//
+// Example:
+//
+//	expr, err := expressparser.NewBuilder().
+//	    AtSecond(0).AtMinute(30).EveryNHours(2).
+//	    OnWeekdays().InMonths(time.January, time.July).
+//	    Build()
+//	// expr.String(): "0 30 */2 * 1,7 1-5"
+type Builder struct {
+	second     []string
+	minute     []string
+	hour       []string
+	dayOfMonth []string
+	month      []string
+	dayOfWeek  []string
+	seconds    bool
+}
+
+// NewBuilder creates a Builder whose fields all match every value
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// AtSecond runs at the given seconds
+func (b *Builder) AtSecond(seconds ...int) *Builder {
+	b.seconds = true
+	b.second = append(b.second, joinInts(seconds))
+	return b
+}
+
+// EverySecond runs every second
+func (b *Builder) EverySecond() *Builder {
+	b.seconds = true
+	b.second = append(b.second, "*")
+	return b
+}
+
+// EveryNSeconds runs every n seconds, starting at second 0
+func (b *Builder) EveryNSeconds(n int) *Builder {
+	b.seconds = true
+	b.second = append(b.second, step("*", n))
+	return b
+}
+
+// SecondsBetween runs every second from first to last
+func (b *Builder) SecondsBetween(first, last int) *Builder {
+	b.seconds = true
+	b.second = append(b.second, span(first, last))
+	return b
+}
+
+// EveryNSecondsBetween runs every n seconds from first to last
+func (b *Builder) EveryNSecondsBetween(n, first, last int) *Builder {
+	b.seconds = true
+	b.second = append(b.second, step(span(first, last), n))
+	return b
+}
+
+// AtMinute runs at the given minutes
+func (b *Builder) AtMinute(minutes ...int) *Builder {
+	b.minute = append(b.minute, joinInts(minutes))
+	return b
+}
+
+// EveryNMinutes runs every n minutes, starting at minute 0
+func (b *Builder) EveryNMinutes(n int) *Builder {
+	b.minute = append(b.minute, step("*", n))
+	return b
+}
+
+// MinutesBetween runs every minute from first to last
+func (b *Builder) MinutesBetween(first, last int) *Builder {
+	b.minute = append(b.minute, span(first, last))
+	return b
+}
+
+// EveryNMinutesBetween runs every n minutes from first to last
+func (b *Builder) EveryNMinutesBetween(n, first, last int) *Builder {
+	b.minute = append(b.minute, step(span(first, last), n))
+	return b
+}
+
+// AtHour runs in the given hours
+func (b *Builder) AtHour(hours ...int) *Builder {
+	b.hour = append(b.hour, joinInts(hours))
+	return b
+}
+
+// EveryNHours runs every n hours, starting at midnight
+func (b *Builder) EveryNHours(n int) *Builder {
+	b.hour = append(b.hour, step("*", n))
+	return b
+}
+
+// HoursBetween runs in every hour from first to last
+func (b *Builder) HoursBetween(first, last int) *Builder {
+	b.hour = append(b.hour, span(first, last))
+	return b
+}
+
+// EveryNHoursBetween runs every n hours from first to last
+func (b *Builder) EveryNHoursBetween(n, first, last int) *Builder {
+	b.hour = append(b.hour, step(span(first, last), n))
+	return b
+}
+
+// OnDaysOfMonth runs on the given days of the month
+func (b *Builder) OnDaysOfMonth(days ...int) *Builder {
+	b.dayOfMonth = append(b.dayOfMonth, joinInts(days))
+	return b
+}
+
+// EveryNDays runs every n days of the month, starting on the 1st
+func (b *Builder) EveryNDays(n int) *Builder {
+	b.dayOfMonth = append(b.dayOfMonth, step("*", n))
+	return b
+}
+
+// DaysOfMonthBetween runs on every day of the month from first to last
+func (b *Builder) DaysOfMonthBetween(first, last int) *Builder {
+	b.dayOfMonth = append(b.dayOfMonth, span(first, last))
+	return b
+}
+
+// EveryNDaysBetween runs every n days of the month from first to last
+func (b *Builder) EveryNDaysBetween(n, first, last int) *Builder {
+	b.dayOfMonth = append(b.dayOfMonth, step(span(first, last), n))
+	return b
+}
+
+// LastDayOfMonth runs on the last day of the month (L)
+func (b *Builder) LastDayOfMonth() *Builder {
+	b.dayOfMonth = append(b.dayOfMonth, "L")
+	return b
+}
+
+// LastWeekdayOfMonth runs on the last Monday to Friday of the month (LW)
+func (b *Builder) LastWeekdayOfMonth() *Builder {
+	b.dayOfMonth = append(b.dayOfMonth, "LW")
+	return b
+}
+
+// DaysBeforeLastDay runs n days before the last day of the month (L-n)
+func (b *Builder) DaysBeforeLastDay(n int) *Builder {
+	b.dayOfMonth = append(b.dayOfMonth, "L-"+strconv.Itoa(n))
+	return b
+}
+
+// NearestWeekday runs on the Monday to Friday nearest the given day of the
+// month (dayW)
+func (b *Builder) NearestWeekday(day int) *Builder {
+	b.dayOfMonth = append(b.dayOfMonth, strconv.Itoa(day)+"W")
+	return b
+}
+
+// InMonths runs in the given months
+func (b *Builder) InMonths(months ...time.Month) *Builder {
+	values := make([]int, len(months))
+	for i, m := range months {
+		values[i] = int(m)
+	}
+	b.month = append(b.month, joinInts(values))
+	return b
+}
+
+// EveryNMonths runs every n months, starting in January
+func (b *Builder) EveryNMonths(n int) *Builder {
+	b.month = append(b.month, step("*", n))
+	return b
+}
+
+// MonthsBetween runs in every month from first to last
+func (b *Builder) MonthsBetween(first, last time.Month) *Builder {
+	b.month = append(b.month, span(int(first), int(last)))
+	return b
+}
+
+// EveryNMonthsBetween runs every n months from first to last
+func (b *Builder) EveryNMonthsBetween(n int, first, last time.Month) *Builder {
+	b.month = append(b.month, step(span(int(first), int(last)), n))
+	return b
+}
+
+// OnDaysOfWeek runs on the given days of the week
+func (b *Builder) OnDaysOfWeek(days ...time.Weekday) *Builder {
+	b.dayOfWeek = append(b.dayOfWeek, joinInts(weekdayValues(days)))
+	return b
+}
+
+// DaysOfWeekBetween runs on every day of the week from first to last
+func (b *Builder) DaysOfWeekBetween(first, last time.Weekday) *Builder {
+	b.dayOfWeek = append(b.dayOfWeek, span(int(first), int(last)))
+	return b
+}
+
+// OnWeekdays runs Monday to Friday
+func (b *Builder) OnWeekdays() *Builder {
+	return b.DaysOfWeekBetween(time.Monday, time.Friday)
+}
+
+// OnWeekends runs on Saturday and Sunday
+func (b *Builder) OnWeekends() *Builder {
+	return b.OnDaysOfWeek(time.Sunday, time.Saturday)
+}
+
+// OnLast runs on the last given day of the week of the month, e.g.
+// OnLast(time.Friday) is 5L
+func (b *Builder) OnLast(day time.Weekday) *Builder {
+	b.dayOfWeek = append(b.dayOfWeek, strconv.Itoa(int(day))+"L")
+	return b
+}
+
+// OnNth runs on the nth given day of the week of the month, e.g.
+// OnNth(2, time.Monday) is 1#2
+func (b *Builder) OnNth(n int, day time.Weekday) *Builder {
+	b.dayOfWeek = append(b.dayOfWeek, strconv.Itoa(int(day))+"#"+strconv.Itoa(n))
+	return b
+}
+
+// builderField is the parts added to one field of a Builder
+type builderField struct {
+	typ   FieldType
+	parts []string
+}
+
+// fields returns the builder's fields in expression order
+func (b *Builder) fields() []builderField {
+	fields := []builderField{
+		{FieldMinute, b.minute}, {FieldHour, b.hour}, {FieldDayOfMonth, b.dayOfMonth},
+		{FieldMonth, b.month}, {FieldDayOfWeek, b.dayOfWeek},
+	}
+	if b.seconds {
+		fields = append([]builderField{{FieldSecond, b.second}}, fields...)
+	}
+	return fields
+}
+
+// String returns the expression built so far in cron syntax, without
+// validating it
+func (b *Builder) String() string {
+	fields := b.fields()
+	strs := make([]string, len(fields))
+	for i, f := range fields {
+		strs[i] = joinParts(f.parts)
+	}
+	return strings.Join(strs, " ")
+}
+
+// Build parses the built expression, failing with the same errors as Parse
+// for out-of-range values or invalid steps, and with a *ParseError if a
+// method was called without values
+func (b *Builder) Build() (*Expression, error) {
+	expr := b.String()
+	for _, f := range b.fields() {
+		for _, part := range f.parts {
+			if part == "" {
+				return nil, NewParseError(expr, string(f.typ), "", "no values given")
+			}
+		}
+	}
+	return Parse(expr)
+}
+
+// MustBuild is like Build but panics if the expression is invalid
+func (b *Builder) MustBuild() *Expression {
+	expr, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
+
+// joinParts joins a field's parts into a list, or "*" if there are none
+func joinParts(parts []string) string {
+	if len(parts) == 0 {
+		return "*"
+	}
+	return strings.Join(parts, ",")
+}
+
+// joinInts joins values into a comma-separated list
+func joinInts(values []int) string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = strconv.Itoa(v)
+	}
+	return strings.Join(strs, ",")
+}
+
+// span formats the range first-last
+func span(first, last int) string {
+	return strconv.Itoa(first) + "-" + strconv.Itoa(last)
+}
+
+// step formats base with a step of n
+func step(base string, n int) string {
+	return base + "/" + strconv.Itoa(n)
+}
+
+// weekdayValues converts days of the week to their cron values
+func weekdayValues(days []time.Weekday) []int {
+	values := make([]int, len(days))
+	for i, d := range days {
+		values[i] = int(d)
+	}
+	return values
+}
@@ -0,0 +1,126 @@
+// builder_test.go - Tests for the expression builder
+
+package expressparser
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBuilder(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *Builder
+		want    string
+	}{
+		{"empty", NewBuilder(), "* * * * *"},
+		{"request example",
+			NewBuilder().AtSecond(0).AtMinute(30).EveryNHours(2).OnWeekdays().
+				InMonths(time.January, time.July).LastDayOfMonth(),
+			"0 30 */2 L 1,7 1-5"},
+		{"daily", NewBuilder().AtMinute(0).AtHour(9), "0 9 * * *"},
+		{"minute steps in hours", NewBuilder().EveryNMinutes(15).HoursBetween(9, 17), "*/15 9-17 * * *"},
+		{"stepped ranges",
+			NewBuilder().EveryNMinutesBetween(10, 5, 55).EveryNHoursBetween(2, 8, 18).
+				EveryNDaysBetween(3, 1, 15).EveryNMonthsBetween(2, time.March, time.November),
+			"5-55/10 8-18/2 1-15/3 3-11/2 *"},
+		{"seconds", NewBuilder().EveryNSecondsBetween(5, 0, 30), "0-30/5 * * * * *"},
+		{"every second", NewBuilder().EverySecond(), "* * * * * *"},
+		{"second range", NewBuilder().SecondsBetween(10, 20).EveryNSeconds(30), "10-20,*/30 * * * * *"},
+		{"minute range", NewBuilder().MinutesBetween(0, 9).AtMinute(30), "0-9,30 * * * *"},
+		{"day steps", NewBuilder().AtMinute(0).AtHour(0).EveryNDays(2).EveryNMonths(3), "0 0 */2 */3 *"},
+		{"day specials",
+			NewBuilder().AtMinute(0).AtHour(0).OnDaysOfMonth(1, 15).LastWeekdayOfMonth().
+				DaysBeforeLastDay(3).NearestWeekday(10),
+			"0 0 1,15,LW,L-3,10W * *"},
+		{"day range", NewBuilder().DaysOfMonthBetween(10, 20).MonthsBetween(time.June, time.August), "* * 10-20 6-8 *"},
+		{"weekday specials",
+			NewBuilder().AtMinute(0).AtHour(12).OnNth(2, time.Monday).OnLast(time.Friday),
+			"0 12 * * 1#2,5L"},
+		{"weekends", NewBuilder().AtMinute(0).OnWeekends(), "0 * * * 0,6"},
+		{"weekdays", NewBuilder().OnDaysOfWeek(time.Tuesday, time.Thursday).DaysOfWeekBetween(time.Friday, time.Saturday), "* * * * 2,4,5-6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := tt.builder.Build()
+			if err != nil {
+				t.Fatalf("Build() error: %v", err)
+			}
+			if expr.Raw != tt.want || expr.String() != tt.want {
+				t.Errorf("Build() Raw = %q, String = %q, want %q", expr.Raw, expr.String(), tt.want)
+			}
+			if !expr.Equal(MustParse(tt.want)) {
+				t.Errorf("Build() does not match Parse(%q)", tt.want)
+			}
+		})
+	}
+}
+
+func TestBuilder_Semantics(t *testing.T) {
+	expr := NewBuilder().AtMinute(0).AtHour(9).OnNth(1, time.Monday).MustBuild()
+	next, err := NewScheduler(expr).Next(time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Next error: %v", err)
+	}
+	if want := time.Date(2024, time.February, 5, 9, 0, 0, 0, time.UTC); !next.Equal(want) {
+		t.Errorf("Next() = %v, want %v", next, want)
+	}
+
+	// L-1 and LW are distinct: in May 2026 the day before the last day is
+	// Saturday the 30th and the last weekday is Friday the 29th
+	may := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		builder *Builder
+		want    int
+	}{
+		{NewBuilder().AtMinute(0).AtHour(0).DaysBeforeLastDay(1), 30},
+		{NewBuilder().AtMinute(0).AtHour(0).LastWeekdayOfMonth(), 29},
+	} {
+		expr := tt.builder.MustBuild()
+		next, err := NewScheduler(expr).Next(may)
+		if err != nil {
+			t.Fatalf("Next error: %v", err)
+		}
+		if want := time.Date(2026, time.May, tt.want, 0, 0, 0, 0, time.UTC); !next.Equal(want) {
+			t.Errorf("%q Next() = %v, want %v", expr.String(), next, want)
+		}
+	}
+
+	expr = NewBuilder().AtMinute(0).AtHour(0).LastDayOfMonth().MustBuild()
+	if !expr.HasLastDayOfMonth {
+		t.Error("LastDayOfMonth did not set HasLastDayOfMonth")
+	}
+}
+
+func TestBuilder_Errors(t *testing.T) {
+	_, err := NewBuilder().AtMinute(60).Build()
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != FieldMinute {
+		t.Errorf("AtMinute(60) error = %v, want minute *FieldError", err)
+	}
+
+	_, err = NewBuilder().EveryNHours(0).Build()
+	var stepErr *StepError
+	if !errors.As(err, &stepErr) {
+		t.Errorf("EveryNHours(0) error = %v, want *StepError", err)
+	}
+
+	_, err = NewBuilder().AtHour().Build()
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Field != string(FieldHour) {
+		t.Errorf("AtHour() error = %v, want hour *ParseError", err)
+	}
+
+	if _, err := NewBuilder().OnNth(6, time.Monday).Build(); err == nil {
+		t.Error("OnNth(6, Monday) should fail")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustBuild should panic for invalid expressions")
+		}
+	}()
+	NewBuilder().AtHour(24).MustBuild()
+}
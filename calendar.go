@@ -0,0 +1,189 @@
+// calendar.go - Month-grid calendar of upcoming occurrences
+
+package expressparser
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CalendarDay summarises the occurrences of a schedule on one day
+type CalendarDay struct {
+	Date  time.Time // Midnight at the start of the day in the scheduler's location
+	Count int       // Number of occurrences on the day
+	First time.Time // First occurrence, zero if Count is 0
+	Last  time.Time // Last occurrence, zero if Count is 0
+}
+
+// Calendar returns one CalendarDay for every day of the given month
+//
+// Days are evaluated in the scheduler's location. Counts are computed from the
+// field values rather than by enumerating occurrences, so even per-second
+// schedules are summarised quickly; hours skipped by a daylight saving
+// transition are not counted.
+func (s *Scheduler) Calendar(year int, month time.Month) []CalendarDay {
+	lastDay := s.lastDayOfMonth(year, month)
+	days := make([]CalendarDay, lastDay)
+
+	perHour := len(s.expr.GetMinutes()) * len(s.expr.GetSeconds())
+
+	for d := 1; d <= lastDay; d++ {
+		date := time.Date(year, month, d, 0, 0, 0, 0, s.location)
+		days[d-1].Date = date
+
+		if !s.MatchesDate(date) {
+			continue
+		}
+
+		for _, h := range s.expr.GetHours() {
+			// Skip hours that do not exist on this day
+			if t := time.Date(year, month, d, h, 0, 0, 0, s.location); t.Hour() == h {
+				days[d-1].Count += perHour
+			}
+		}
+		if days[d-1].Count == 0 {
+			continue
+		}
+
+		next := time.Date(year, month, d+1, 0, 0, 0, 0, s.location)
+		if first, err := s.Next(date.Add(-time.Second)); err == nil && first.Before(next) {
+			days[d-1].First = first
+		}
+		if last, err := s.Previous(next); err == nil && !last.Before(date) {
+			days[d-1].Last = last
+		}
+	}
+
+	return days
+}
+
+// MatchesDate reports whether the month and day fields select the date of t,
+// evaluated in the scheduler's location
+//
+// The time of day is ignored, so a date can match even if all of its
+// selected hours are skipped by a daylight saving transition.
+func (s *Scheduler) MatchesDate(t time.Time) bool {
+	t = t.In(s.location)
+	return s.expr.Month.Contains(int(t.Month())) && s.matchesDay(t)
+}
+
+// CalendarOptions configures RenderCalendar
+type CalendarOptions struct {
+	// Color highlights firing days with ANSI escape codes instead of marking
+	// them with an asterisk
+	Color bool
+
+	// Use24HourTime formats first and last run times as 15:04 instead of 3:04 PM
+	Use24HourTime bool
+}
+
+const (
+	ansiHighlight = "\x1b[1;32m"
+	ansiDim       = "\x1b[2m"
+	ansiReset     = "\x1b[0m"
+)
+
+// RenderCalendar renders a month grid, like cal(1), for each of months
+// consecutive months starting at year/month, followed by a list of the days
+// on which the schedule fires with their run count and first and last time
+//
+// Example output for "0 9 * * 1#2":
+//
+//	    January 2024
+//	Su Mo Tu We Th Fr Sa
+//	    1  2  3  4  5  6
+//	 7  8* 9 10 11 12 13
+//	...
+//
+//	Mon Jan  8     1 run   9:00 AM
+func RenderCalendar(s *Scheduler, year int, month time.Month, months int, opts CalendarOptions) string {
+	var b strings.Builder
+
+	for i := 0; i < months; i++ {
+		first := time.Date(year, month+time.Month(i), 1, 0, 0, 0, 0, s.location)
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		renderMonth(&b, s, first.Year(), first.Month(), opts)
+	}
+
+	return b.String()
+}
+
+// renderMonth writes the grid and run list of a single month
+func renderMonth(b *strings.Builder, s *Scheduler, year int, month time.Month, opts CalendarOptions) {
+	days := s.Calendar(year, month)
+
+	title := fmt.Sprintf("%s %d", month, year)
+	pad := (20 - len(title)) / 2
+	if pad < 0 {
+		pad = 0
+	}
+	b.WriteString(strings.Repeat(" ", pad) + title + "\n")
+	b.WriteString("Su Mo Tu We Th Fr Sa\n")
+
+	col := int(days[0].Date.Weekday())
+	week := strings.Repeat("   ", col)
+	for _, day := range days {
+		num := fmt.Sprintf("%2d", day.Date.Day())
+		switch {
+		case day.Count > 0 && opts.Color:
+			week += ansiHighlight + num + ansiReset + " "
+		case day.Count > 0:
+			week += num + "*"
+		case opts.Color:
+			week += ansiDim + num + ansiReset + " "
+		default:
+			week += num + " "
+		}
+
+		col++
+		if col == 7 || day.Date.Day() == len(days) {
+			b.WriteString(strings.TrimRight(week, " ") + "\n")
+			week = ""
+			col = 0
+		}
+	}
+
+	extended := s.expr.IsExtended()
+	wroteHeader := false
+	for _, day := range days {
+		if day.Count == 0 {
+			continue
+		}
+		if !wroteHeader {
+			b.WriteString("\n")
+			wroteHeader = true
+		}
+
+		runs := "runs"
+		if day.Count == 1 {
+			runs = "run "
+		}
+		line := fmt.Sprintf("%s %5d %s  %s", day.Date.Format("Mon Jan _2"), day.Count, runs,
+			calendarTime(day.First, extended, opts.Use24HourTime))
+		if day.Count > 1 && !day.Last.IsZero() {
+			line += " - " + calendarTime(day.Last, extended, opts.Use24HourTime)
+		}
+		if opts.Color {
+			line = ansiHighlight + line[:10] + ansiReset + line[10:]
+		}
+		b.WriteString(line + "\n")
+	}
+}
+
+// calendarTime formats a run time for the calendar listing
+func calendarTime(t time.Time, seconds, use24h bool) string {
+	if t.IsZero() {
+		return "?"
+	}
+	layout := "3:04 PM"
+	if use24h {
+		layout = "15:04"
+	}
+	if seconds {
+		layout = strings.Replace(layout, "04", "04:05", 1)
+	}
+	return t.Format(layout)
+}
@@ -0,0 +1,116 @@
+package expressparser
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScheduler_Calendar(t *testing.T) {
+	s := NewScheduler(mustParseExpr(t, "*/15 9-17 * * 1-5"))
+	days := s.Calendar(2024, time.February)
+
+	if len(days) != 29 {
+		t.Fatalf("len(Calendar()) = %d, want 29", len(days))
+	}
+
+	mon := days[11] // Monday 12 February
+	if mon.Count != 36 {
+		t.Errorf("Count = %d, want 36", mon.Count)
+	}
+	if want := time.Date(2024, 2, 12, 9, 0, 0, 0, time.UTC); !mon.First.Equal(want) {
+		t.Errorf("First = %v, want %v", mon.First, want)
+	}
+	if want := time.Date(2024, 2, 12, 17, 45, 0, 0, time.UTC); !mon.Last.Equal(want) {
+		t.Errorf("Last = %v, want %v", mon.Last, want)
+	}
+
+	if sat := days[9]; sat.Count != 0 || !sat.First.IsZero() {
+		t.Errorf("Saturday = %+v, want no runs", sat)
+	}
+}
+
+func TestScheduler_Calendar_DSTGap(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	s := NewScheduler(mustParseExpr(t, "30 2 * * *"), WithLocation(loc))
+
+	days := s.Calendar(2024, time.March)
+	if got := days[9].Count; got != 0 {
+		t.Errorf("Count on spring-forward day = %d, want 0", got)
+	}
+	if got := days[10].Count; got != 1 {
+		t.Errorf("Count on following day = %d, want 1", got)
+	}
+}
+
+func TestScheduler_MatchesDate(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	s := NewScheduler(mustParseExpr(t, "0 9 L 1,2 *"), WithLocation(loc))
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2024, 1, 31, 12, 0, 0, 0, loc), true},
+		{time.Date(2024, 1, 30, 12, 0, 0, 0, loc), false},
+		{time.Date(2024, 2, 29, 0, 0, 0, 0, loc), true},
+		{time.Date(2024, 3, 31, 0, 0, 0, 0, loc), false},
+		// 20:00 UTC on the 30th is already the 31st in Tokyo
+		{time.Date(2024, 1, 30, 20, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		if got := s.MatchesDate(tt.t); got != tt.want {
+			t.Errorf("MatchesDate(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestRenderCalendar(t *testing.T) {
+	s := NewScheduler(mustParseExpr(t, "0 9 * * 1#2"))
+	got := RenderCalendar(s, 2024, time.January, 2, CalendarOptions{Use24HourTime: true})
+
+	want := `    January 2024
+Su Mo Tu We Th Fr Sa
+    1  2  3  4  5  6
+ 7  8* 9 10 11 12 13
+14 15 16 17 18 19 20
+21 22 23 24 25 26 27
+28 29 30 31
+
+Mon Jan  8     1 run   09:00
+
+   February 2024
+Su Mo Tu We Th Fr Sa
+             1  2  3
+ 4  5  6  7  8  9 10
+11 12*13 14 15 16 17
+18 19 20 21 22 23 24
+25 26 27 28 29
+
+Mon Feb 12     1 run   09:00
+`
+	if got != want {
+		t.Errorf("RenderCalendar() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderCalendar_Color(t *testing.T) {
+	s := NewScheduler(mustParseExpr(t, "0 */6 1 * *"))
+	got := RenderCalendar(s, 2024, time.March, 1, CalendarOptions{Color: true})
+
+	if strings.Contains(got, "*") {
+		t.Errorf("colour output contains asterisk markers:\n%s", got)
+	}
+	if !strings.Contains(got, ansiHighlight+" 1"+ansiReset) {
+		t.Errorf("colour output does not highlight the 1st:\n%q", got)
+	}
+	if !strings.Contains(got, "4 runs  12:00 AM - 6:00 PM") {
+		t.Errorf("colour output missing run summary:\n%q", got)
+	}
+}
@@ -0,0 +1,162 @@
+// canonical.go - Canonical string form of expressions
+
+package expressparser
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Canonical returns a minimal canonical form of the expression
+//
+// Expressions that expand to the same field values produce identical strings,
+// so "0-59 * * * *", "*/1 * * * *" and "* * * * *" all become "* * * * *".
+// Fields are rendered from their expanded values:
+//   - names are replaced by numbers ("MON-FRI" becomes "1-5")
+//   - "?" and full ranges become "*"
+//   - runs of three or more values become ranges, and evenly spaced values
+//     become steps when that is shorter ("0,15,30,45" becomes "*/15")
+//   - special day values are upper case and follow the plain values, e.g.
+//     "1,15,L" or "1,5L"
+//
+// A seconds field that is exactly "0" is dropped, since a 6-field expression
+// firing at second 0 is the same schedule as its 5-field form.
+func (e *Expression) Canonical() string {
+	fields := []*Field{e.Minute, e.Hour, e.DayOfMonth, e.Month, e.DayOfWeek}
+	if e.IsExtended() && !(len(e.Second.Values) == 1 && e.Second.Contains(0)) {
+		fields = append([]*Field{e.Second}, fields...)
+	}
+
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		parts[i] = canonicalField(f)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Normalize returns a copy of the expression parsed from its canonical form
+func (e *Expression) Normalize() *Expression {
+	n, err := parseCron(e.Canonical())
+	if err != nil {
+		// The canonical form of a parsed expression always parses
+		return e
+	}
+	return n
+}
+
+// canonicalField renders a field's plain values followed by its special day
+// values
+func canonicalField(f *Field) string {
+	bounds := fieldBounds[f.Type]
+
+	var plain, special []int
+	for v := range f.Values {
+		if v >= bounds.min && v <= bounds.max {
+			plain = append(plain, v)
+		} else {
+			special = append(special, v)
+		}
+	}
+	sort.Ints(plain)
+	sort.Ints(special)
+
+	var parts []string
+	switch {
+	case len(plain) == bounds.max-bounds.min+1 && len(special) == 0:
+		return "*"
+	case len(plain) == bounds.max-bounds.min+1:
+		// "*" cannot be combined with other list elements
+		parts = append(parts, strconv.Itoa(bounds.min)+"-"+strconv.Itoa(bounds.max))
+	case len(plain) > 0:
+		parts = append(parts, canonicalValues(plain, bounds))
+	}
+
+	for _, v := range special {
+		parts = append(parts, canonicalSpecial(f.Type, v))
+	}
+	return strings.Join(parts, ",")
+}
+
+// canonicalValues renders sorted values as a list of runs, or as a step when
+// the values are evenly spaced and the step is shorter
+func canonicalValues(values []int, bounds fieldBound) string {
+	list := canonicalRuns(values)
+
+	if len(values) < 2 {
+		return list
+	}
+	step := values[1] - values[0]
+	if step < 2 {
+		return list
+	}
+	for i := 2; i < len(values); i++ {
+		if values[i]-values[i-1] != step {
+			return list
+		}
+	}
+
+	first, last := values[0], values[len(values)-1]
+	var stepped string
+	switch {
+	case last+step <= bounds.max:
+		stepped = strconv.Itoa(first) + "-" + strconv.Itoa(last)
+	case first == bounds.min:
+		stepped = "*"
+	default:
+		stepped = strconv.Itoa(first)
+	}
+	stepped += "/" + strconv.Itoa(step)
+
+	if len(stepped) < len(list) {
+		return stepped
+	}
+	return list
+}
+
+// canonicalRuns renders sorted values as a comma-separated list, collapsing
+// runs of three or more consecutive values into ranges
+func canonicalRuns(values []int) string {
+	var parts []string
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		switch {
+		case j-i >= 2:
+			parts = append(parts, strconv.Itoa(values[i])+"-"+strconv.Itoa(values[j]))
+		default:
+			for k := i; k <= j; k++ {
+				parts = append(parts, strconv.Itoa(values[k]))
+			}
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
+
+// canonicalSpecial renders an encoded special day value
+func canonicalSpecial(field FieldType, v int) string {
+	if field == FieldDayOfMonth {
+		switch {
+		case v == 32:
+			return "L"
+		case v == lastWeekdayValue:
+			return "LW"
+		case v > 32 && v < lastWeekdayValue:
+			return "L-" + strconv.Itoa(v-32)
+		case v > 100:
+			return strconv.Itoa(v-100) + "W"
+		}
+	}
+	if field == FieldDayOfWeek {
+		switch {
+		case v >= 10 && v <= 16:
+			return strconv.Itoa(v-10) + "L"
+		case v >= 21 && v <= 75:
+			return strconv.Itoa((v-20)/10) + "#" + strconv.Itoa((v-20)%10)
+		}
+	}
+	return strconv.Itoa(v)
+}
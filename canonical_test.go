@@ -0,0 +1,98 @@
+// canonical_test.go - Tests for canonical expression strings
+
+package expressparser
+
+import "testing"
+
+func TestExpression_Canonical(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"* * * * *", "* * * * *"},
+		{"0-59 * * * *", "* * * * *"},
+		{"*/1 * * * *", "* * * * *"},
+		{"0 0 ? * *", "0 0 * * *"},
+		{"0,15,30,45 * * * *", "*/15 * * * *"},
+		{"0-59/15 * * * *", "*/15 * * * *"},
+		{"5/15 * * * *", "5/15 * * * *"},
+		{"0 9-17/2 * * *", "0 9-17/2 * * *"},
+		{"0 0,12 * * *", "0 0,12 * * *"},
+		{"0 9,10,11,12,14 * * *", "0 9-12,14 * * *"},
+		{"0 9 * * MON-FRI", "0 9 * * 1-5"},
+		{"0 9 * * fri,mon", "0 9 * * 1,5"},
+		{"0 0 1 JAN-DEC *", "0 0 1 * *"},
+		{"0 0 1 jan,jul *", "0 0 1 1,7 *"},
+		{"0 0 l * *", "0 0 L * *"},
+		{"0 0 15,1,l * *", "0 0 1,15,L * *"},
+		{"0 0 15w * *", "0 0 15W * *"},
+		{"0 0 L-3 * *", "0 0 L-3 * *"},
+		{"0 0 * * 5l", "0 0 * * 5L"},
+		{"0 0 * * MON#2", "0 0 * * 1#2"},
+		{"@daily", "0 0 * * *"},
+		{"@hourly", "0 * * * *"},
+		{"0 30 9 * * *", "30 9 * * *"},
+		{"*/10 30 9 * * *", "*/10 30 9 * * *"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			e := MustParse(tt.expr)
+			if got := e.Canonical(); got != tt.want {
+				t.Errorf("Canonical() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpression_CanonicalEquivalents(t *testing.T) {
+	groups := [][]string{
+		{"* * * * *", "0-59 * * * *", "*/1 * * * *", "0 * * * * *"},
+		{"0 9 * * 1-5", "0 9 ? * MON-FRI", "00 09 * * mon,tue,wed,thu,fri"},
+		{"*/20 * * * *", "0,20,40 * * * *", "0-59/20 * * * *"},
+	}
+
+	for _, group := range groups {
+		want := MustParse(group[0]).Canonical()
+		for _, expr := range group[1:] {
+			if got := MustParse(expr).Canonical(); got != want {
+				t.Errorf("Canonical(%q) = %q, want %q", expr, got, want)
+			}
+		}
+	}
+}
+
+func TestExpression_Normalize(t *testing.T) {
+	e := MustParse("0 9-17/2 ? JAN-MAR MON-FRI")
+	n := e.Normalize()
+
+	if n.String() != "0 9-17/2 * 1-3 1-5" {
+		t.Errorf("String() = %q", n.String())
+	}
+	for _, f := range []FieldType{FieldMinute, FieldHour, FieldDayOfMonth, FieldMonth, FieldDayOfWeek} {
+		a, b := fieldOf(e, f), fieldOf(n, f)
+		if len(a.Values) != len(b.Values) {
+			t.Errorf("%v values differ: %v vs %v", f, a.All(), b.All())
+		}
+	}
+	if n.Normalize().String() != n.String() {
+		t.Error("Normalize is not idempotent")
+	}
+}
+
+func fieldOf(e *Expression, f FieldType) *Field {
+	switch f {
+	case FieldSecond:
+		return e.Second
+	case FieldMinute:
+		return e.Minute
+	case FieldHour:
+		return e.Hour
+	case FieldDayOfMonth:
+		return e.DayOfMonth
+	case FieldMonth:
+		return e.Month
+	default:
+		return e.DayOfWeek
+	}
+}
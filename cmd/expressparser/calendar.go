@@ -0,0 +1,83 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SravanKolanu20/expressparser"
+)
+
+func runCal(e *env, args []string) int {
+	var jsonOut, color, use24h bool
+	var months int
+	var tz, from string
+	fs := newFlagSet(e, "cal", &jsonOut)
+	fs.IntVar(&months, "months", 1, "number of months to show")
+	fs.StringVar(&tz, "tz", "UTC", "IANA timezone to evaluate the expression in")
+	fs.StringVar(&from, "from", "", "first month in YYYY-MM format (default current month)")
+	fs.BoolVar(&color, "color", false, "highlight run days with ANSI colours")
+	fs.BoolVar(&use24h, "24h", false, "use 24-hour time")
+	expr, code, ok := parseArgs(fs, args)
+	if !ok {
+		return code
+	}
+	if months < 1 {
+		fmt.Fprintf(e.stderr, "invalid --months %d: must be at least 1\n", months)
+		return exitUsage
+	}
+
+	schedule, err := expressparser.NewScheduleInTimezone(expr, tz)
+	if errors.Is(err, expressparser.ErrInvalidTimezone) {
+		fmt.Fprintf(e.stderr, "invalid --tz %q: %v\n", tz, err)
+		return exitUsage
+	}
+	if err != nil {
+		return e.fail(expr, err, jsonOut)
+	}
+
+	start := e.now().In(schedule.Timezone())
+	if from != "" {
+		t, err := time.Parse("2006-01", from)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "invalid --from month %q: want YYYY-MM\n", from)
+			return exitUsage
+		}
+		start = t
+	}
+
+	scheduler := schedule.Scheduler()
+	if !jsonOut {
+		fmt.Fprint(e.stdout, expressparser.RenderCalendar(scheduler, start.Year(), start.Month(), months,
+			expressparser.CalendarOptions{Color: color, Use24HourTime: use24h}))
+		return exitOK
+	}
+
+	type dayInfo struct {
+		Date  string `json:"date"`
+		Count int    `json:"count"`
+		First string `json:"first"`
+		Last  string `json:"last"`
+	}
+	days := make([]dayInfo, 0)
+	for i := 0; i < months; i++ {
+		month := time.Date(start.Year(), start.Month()+time.Month(i), 1, 0, 0, 0, 0, time.UTC)
+		for _, day := range scheduler.Calendar(month.Year(), month.Month()) {
+			if day.Count == 0 {
+				continue
+			}
+			days = append(days, dayInfo{
+				Date:  day.Date.Format(time.DateOnly),
+				Count: day.Count,
+				First: day.First.Format(time.RFC3339),
+				Last:  day.Last.Format(time.RFC3339),
+			})
+		}
+	}
+	e.writeJSON(struct {
+		Expression string    `json:"expression"`
+		Timezone   string    `json:"timezone"`
+		Days       []dayInfo `json:"days"`
+	}{expr, schedule.Timezone().String(), days})
+	return exitOK
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/SravanKolanu20/expressparser"
+	"github.com/SravanKolanu20/expressparser/crontab"
+)
+
+// lintResult is the JSON form of the diagnostics of one expression
+type lintResult struct {
+	Line        int                        `json:"line,omitempty"`
+	Expression  string                     `json:"expression"`
+	Diagnostics []expressparser.Diagnostic `json:"diagnostics"`
+	Error       *errorInfo                 `json:"error,omitempty"`
+}
+
+func runLint(e *env, args []string) int {
+	var jsonOut, fiveField, system bool
+	var failOn, file string
+	fs := newFlagSet(e, "lint", &jsonOut)
+	fs.BoolVar(&fiveField, "five-field", false, "report seconds fields, for classic 5-field cron daemons")
+	fs.StringVar(&failOn, "fail-on", "error", "exit with status 1 on diagnostics of this severity or higher (info, warning, error)")
+	fs.StringVar(&file, "file", "", "lint every entry of a crontab file instead of a single expression")
+	fs.BoolVar(&system, "system", false, "the crontab file has a user column, as in /etc/crontab")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	threshold := expressparser.Severity(failOn)
+	switch threshold {
+	case expressparser.SeverityInfo, expressparser.SeverityWarning, expressparser.SeverityError:
+	default:
+		fmt.Fprintf(e.stderr, "invalid --fail-on %q: want info, warning or error\n", failOn)
+		return exitUsage
+	}
+
+	var opts []expressparser.LintOption
+	if fiveField {
+		opts = append(opts, expressparser.WithFiveFieldExecutor())
+	}
+
+	var results []lintResult
+	if file != "" {
+		var err error
+		results, err = lintFile(file, system, opts)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "lint: %v\n", err)
+			return exitUsage
+		}
+	} else {
+		if fs.NArg() == 0 {
+			fs.Usage()
+			return exitUsage
+		}
+		expr := strings.Join(fs.Args(), " ")
+		diags, err := expressparser.Lint(expr, opts...)
+		if err != nil {
+			return e.fail(expr, err, jsonOut)
+		}
+		results = []lintResult{{Expression: expr, Diagnostics: diags}}
+	}
+
+	code := exitOK
+	for i := range results {
+		if results[i].Diagnostics == nil {
+			results[i].Diagnostics = []expressparser.Diagnostic{}
+		}
+		if results[i].Error != nil {
+			code = exitInvalid
+		}
+		for _, d := range results[i].Diagnostics {
+			if d.Severity.AtLeast(threshold) {
+				code = exitInvalid
+			}
+		}
+	}
+
+	if jsonOut {
+		e.writeJSON(results)
+		return code
+	}
+
+	for _, r := range results {
+		prefix := ""
+		if r.Line > 0 {
+			prefix = fmt.Sprintf("line %d: ", r.Line)
+		}
+		if r.Error != nil {
+			fmt.Fprintf(e.stdout, "%serror[invalid] %s\n", prefix, r.Error.Message)
+			continue
+		}
+		for _, d := range r.Diagnostics {
+			fmt.Fprintf(e.stdout, "%s%s\n", prefix, d)
+			if d.Suggestion != "" {
+				fmt.Fprintf(e.stdout, "  suggestion: %s\n", d.Suggestion)
+			}
+			if d.Fix != "" {
+				fmt.Fprintf(e.stdout, "  fix:        %s\n", d.Fix)
+			}
+		}
+	}
+	return code
+}
+
+// lintFile lints every schedule in a crontab file
+func lintFile(path string, system bool, opts []expressparser.LintOption) ([]lintResult, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var parseOpts []crontab.Option
+	if system {
+		parseOpts = append(parseOpts, crontab.WithUserColumn())
+	}
+	tab, err := crontab.Parse(f, parseOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []lintResult
+	for _, line := range tab.Lines {
+		switch {
+		case line.Kind == crontab.LineInvalid:
+			results = append(results, lintResult{
+				Line:       line.Number,
+				Expression: strings.TrimSpace(line.Text),
+				Error:      newErrorInfo(line.Err.Err),
+			})
+		case line.Kind == crontab.LineEntry && line.Entry.Expression != nil:
+			results = append(results, lintResult{
+				Line:        line.Number,
+				Expression:  line.Entry.Schedule,
+				Diagnostics: line.Entry.Expression.Lint(opts...),
+			})
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,415 @@
+// Command expressparser validates, describes and previews cron expressions.
+//
+// Usage:
+//
+//	expressparser <command> [flags] <expression>
+//
+// Commands:
+//
+//	validate   Check that an expression parses
+//	describe   Print a human-readable description
+//	next       Print the next N run times
+//	prev       Print the previous N run times
+//	explain    Print the values matched by each field
+//	cal        Print a month calendar highlighting the days the expression fires
+//	lint       Report valid but surprising constructs
+//
+// The expression may be passed as a single quoted argument or as separate
+// arguments, e.g. expressparser next -n 3 --tz Europe/Berlin '0 9 * * 1#2'.
+// Every command accepts --json for machine-readable output. The exit status is
+// 1 when the expression is invalid and 2 on usage errors.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/SravanKolanu20/expressparser"
+)
+
+const (
+	exitOK      = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+// command is a subcommand of the tool
+type command struct {
+	name    string
+	summary string
+	run     func(env *env, args []string) int
+}
+
+var commands = []command{
+	{"validate", "Check that an expression parses", runValidate},
+	{"describe", "Print a human-readable description", runDescribe},
+	{"next", "Print the next N run times", runNext},
+	{"prev", "Print the previous N run times", runPrev},
+	{"explain", "Print the values matched by each field", runExplain},
+	{"cal", "Print a month calendar of run days", runCal},
+	{"lint", "Report valid but surprising constructs", runLint},
+}
+
+// env holds the output streams and clock used by commands
+type env struct {
+	stdout io.Writer
+	stderr io.Writer
+	now    func() time.Time
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	e := &env{stdout: stdout, stderr: stderr, now: time.Now}
+
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return exitUsage
+		}
+		return exitOK
+	}
+
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(e, args[1:])
+		}
+	}
+
+	fmt.Fprintf(stderr, "expressparser: unknown command %q\n\n", args[0])
+	usage(stderr)
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: expressparser <command> [flags] <expression>")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'expressparser <command> -h' for the flags of a command.")
+}
+
+// newFlagSet creates the flag set of a command with the shared --json flag
+func newFlagSet(e *env, name string, jsonOut *bool) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.BoolVar(jsonOut, "json", false, "write JSON output")
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: expressparser %s [flags] <expression>\n\nFlags:\n", name)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseArgs parses flags and returns the expression formed by the remaining
+// arguments, or an exit code if parsing should stop
+func parseArgs(fs *flag.FlagSet, args []string) (string, int, bool) {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return "", exitOK, false
+		}
+		return "", exitUsage, false
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return "", exitUsage, false
+	}
+	return strings.Join(fs.Args(), " "), exitOK, true
+}
+
+// errorInfo is the JSON form of a parse error
+type errorInfo struct {
+	Message    string `json:"message"`
+	Field      string `json:"field,omitempty"`
+	Value      string `json:"value,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Min        *int   `json:"min,omitempty"`
+	Max        *int   `json:"max,omitempty"`
+	Offset     *int   `json:"offset,omitempty"`
+	Length     int    `json:"length,omitempty"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+func newErrorInfo(err error) *errorInfo {
+	info := &errorInfo{Message: err.Error()}
+
+	var spanErr interface{ Span() (int, int, bool) }
+	if errors.As(err, &spanErr) {
+		if start, end, ok := spanErr.Span(); ok {
+			info.Offset = &start
+			info.Length = end - start
+		}
+	}
+
+	var fieldErr *expressparser.FieldError
+	var parseErr *expressparser.ParseError
+	var rangeErr *expressparser.RangeError
+	var stepErr *expressparser.StepError
+	switch {
+	case errors.As(err, &fieldErr):
+		info.Field = string(fieldErr.Field)
+		info.Value = fieldErr.Value
+		info.Reason = fieldErr.Reason
+		info.Min = &fieldErr.Min
+		info.Max = &fieldErr.Max
+		info.Suggestion = fieldErr.Suggestion
+	case errors.As(err, &parseErr):
+		info.Field = parseErr.Field
+		info.Value = parseErr.Value
+		info.Reason = parseErr.Reason
+		info.Suggestion = parseErr.Suggestion
+	case errors.As(err, &rangeErr):
+		info.Field = string(rangeErr.Field)
+		info.Reason = "start is greater than end"
+	case errors.As(err, &stepErr):
+		info.Field = string(stepErr.Field)
+		info.Reason = "step must be positive"
+	}
+	return info
+}
+
+// fail reports an invalid expression and returns exitInvalid
+func (e *env) fail(expr string, err error, jsonOut bool) int {
+	if jsonOut {
+		e.writeJSON(struct {
+			Expression string     `json:"expression"`
+			Valid      bool       `json:"valid"`
+			Error      *errorInfo `json:"error"`
+		}{expr, false, newErrorInfo(err)})
+		return exitInvalid
+	}
+
+	fmt.Fprintf(e.stderr, "invalid expression %q: %v\n", expr, err)
+	info := newErrorInfo(err)
+	if info.Offset != nil {
+		fmt.Fprintf(e.stderr, "\n  %s\n  %s%s\n\n", expr, strings.Repeat(" ", *info.Offset), strings.Repeat("^", info.Length))
+	}
+	if info.Min != nil {
+		fmt.Fprintf(e.stderr, "  field:   %s\n  value:   %q\n  reason:  %s\n  allowed: %d-%d\n",
+			info.Field, info.Value, info.Reason, *info.Min, *info.Max)
+	}
+	return exitInvalid
+}
+
+func (e *env) writeJSON(v any) {
+	enc := json.NewEncoder(e.stdout)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+func runValidate(e *env, args []string) int {
+	var jsonOut bool
+	fs := newFlagSet(e, "validate", &jsonOut)
+	expr, code, ok := parseArgs(fs, args)
+	if !ok {
+		return code
+	}
+
+	if _, err := expressparser.Parse(expr); err != nil {
+		return e.fail(expr, err, jsonOut)
+	}
+
+	if jsonOut {
+		e.writeJSON(struct {
+			Expression string `json:"expression"`
+			Valid      bool   `json:"valid"`
+		}{expr, true})
+		return exitOK
+	}
+	fmt.Fprintln(e.stdout, "valid")
+	return exitOK
+}
+
+func runDescribe(e *env, args []string) int {
+	var jsonOut, use24h, verbose bool
+	var locale string
+	fs := newFlagSet(e, "describe", &jsonOut)
+	fs.BoolVar(&use24h, "24h", false, "use 24-hour time")
+	fs.BoolVar(&verbose, "verbose", false, "generate a more detailed description")
+	fs.StringVar(&locale, "locale", "en", "description language, one of "+strings.Join(expressparser.Locales(), ", "))
+	expr, code, ok := parseArgs(fs, args)
+	if !ok {
+		return code
+	}
+
+	parsed, err := expressparser.Parse(expr)
+	if err != nil {
+		return e.fail(expr, err, jsonOut)
+	}
+
+	opts := expressparser.DefaultDescriptionOptions()
+	opts.Use24HourTime = use24h
+	opts.Verbose = verbose
+	opts.Locale = locale
+	desc := expressparser.DescribeWithOptions(parsed, opts)
+
+	if jsonOut {
+		e.writeJSON(struct {
+			Expression  string `json:"expression"`
+			Description string `json:"description"`
+		}{expr, desc})
+		return exitOK
+	}
+	fmt.Fprintln(e.stdout, desc)
+	return exitOK
+}
+
+func runNext(e *env, args []string) int {
+	return runTimes(e, "next", args)
+}
+
+func runPrev(e *env, args []string) int {
+	return runTimes(e, "prev", args)
+}
+
+// runTimes implements next and prev
+func runTimes(e *env, name string, args []string) int {
+	var jsonOut bool
+	var n int
+	var tz, from string
+	fs := newFlagSet(e, name, &jsonOut)
+	fs.IntVar(&n, "n", 5, "number of run times")
+	fs.StringVar(&tz, "tz", "UTC", "IANA timezone to evaluate the expression in")
+	fs.StringVar(&from, "from", "", "start time in RFC 3339 format (default now)")
+	expr, code, ok := parseArgs(fs, args)
+	if !ok {
+		return code
+	}
+
+	start := e.now()
+	if from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "invalid --from time %q: %v\n", from, err)
+			return exitUsage
+		}
+		start = t
+	}
+
+	schedule, err := expressparser.NewScheduleInTimezone(expr, tz)
+	if errors.Is(err, expressparser.ErrInvalidTimezone) {
+		fmt.Fprintf(e.stderr, "invalid --tz %q: %v\n", tz, err)
+		return exitUsage
+	}
+	if err != nil {
+		return e.fail(expr, err, jsonOut)
+	}
+
+	var times []time.Time
+	if name == "next" {
+		times, err = schedule.NextN(start, n)
+	} else {
+		times, err = schedule.PreviousN(start, n)
+	}
+	if err != nil {
+		fmt.Fprintf(e.stderr, "%s: %v\n", name, err)
+		return exitInvalid
+	}
+
+	if jsonOut {
+		formatted := make([]string, len(times))
+		for i, t := range times {
+			formatted[i] = t.Format(time.RFC3339)
+		}
+		e.writeJSON(struct {
+			Expression string   `json:"expression"`
+			Timezone   string   `json:"timezone"`
+			Times      []string `json:"times"`
+		}{expr, schedule.Timezone().String(), formatted})
+		return exitOK
+	}
+	for _, t := range times {
+		fmt.Fprintln(e.stdout, t.Format("Mon 2006-01-02 15:04:05 MST"))
+	}
+	return exitOK
+}
+
+// fieldInfo is the breakdown of one field printed by explain
+type fieldInfo struct {
+	Name    string   `json:"name"`
+	Raw     string   `json:"raw"`
+	Values  []int    `json:"values"`
+	Special []string `json:"special,omitempty"`
+}
+
+func runExplain(e *env, args []string) int {
+	var jsonOut bool
+	fs := newFlagSet(e, "explain", &jsonOut)
+	expr, code, ok := parseArgs(fs, args)
+	if !ok {
+		return code
+	}
+
+	parsed, err := expressparser.Parse(expr)
+	if err != nil {
+		return e.fail(expr, err, jsonOut)
+	}
+
+	explanation := parsed.Explain()
+	infos := make([]fieldInfo, len(explanation.Fields))
+	for i, f := range explanation.Fields {
+		infos[i] = fieldInfo{Name: string(f.Field), Raw: f.Raw, Values: f.Values}
+		for _, rule := range f.Special {
+			infos[i].Special = append(infos[i].Special, rule.Token)
+		}
+	}
+
+	if jsonOut {
+		e.writeJSON(struct {
+			Expression  string      `json:"expression"`
+			Description string      `json:"description"`
+			Fields      []fieldInfo `json:"fields"`
+		}{expr, explanation.Description, infos})
+		return exitOK
+	}
+
+	fmt.Fprintln(e.stdout, explanation.Description)
+	fmt.Fprintln(e.stdout)
+	for _, info := range infos {
+		values := formatValues(info.Values)
+		if len(info.Special) > 0 {
+			special := "special " + strings.Join(info.Special, ",")
+			if len(info.Values) == 0 {
+				values = special
+			} else {
+				values += "; " + special
+			}
+		}
+		fmt.Fprintf(e.stdout, "%-13s %-10s %s\n", info.Name, info.Raw, values)
+	}
+	return exitOK
+}
+
+// formatValues renders values compactly, collapsing consecutive runs
+func formatValues(values []int) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	var parts []string
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			parts = append(parts, fmt.Sprintf("%d-%d", values[i], values[j]))
+		} else {
+			for k := i; k <= j; k++ {
+				parts = append(parts, fmt.Sprint(values[k]))
+			}
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runCLI(t *testing.T, args ...string) (string, string, int) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+func TestValidate(t *testing.T) {
+	out, _, code := runCLI(t, "validate", "0 9 * * 1#2")
+	if code != exitOK || out != "valid\n" {
+		t.Errorf("validate valid expression = %q, exit %d", out, code)
+	}
+
+	_, errOut, code := runCLI(t, "validate", "0", "25", "*", "*", "*")
+	if code != exitInvalid {
+		t.Errorf("validate invalid expression exit = %d, want %d", code, exitInvalid)
+	}
+	for _, want := range []string{"field:   hour", `value:   "25"`, "allowed: 0-23"} {
+		if !strings.Contains(errOut, want) {
+			t.Errorf("stderr missing %q:\n%s", want, errOut)
+		}
+	}
+}
+
+func TestValidate_JSON(t *testing.T) {
+	out, _, code := runCLI(t, "validate", "--json", "* * 32 * *")
+	if code != exitInvalid {
+		t.Fatalf("exit = %d, want %d", code, exitInvalid)
+	}
+
+	var got struct {
+		Valid bool
+		Error struct {
+			Field string
+			Value string
+			Min   int
+			Max   int
+		}
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", out, err)
+	}
+	if got.Valid || got.Error.Field != "day-of-month" || got.Error.Value != "32" || got.Error.Max != 31 {
+		t.Errorf("JSON = %+v", got)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	out, _, code := runCLI(t, "describe", "--24h", "0 9 * * 1-5")
+	if code != exitOK || out != "At 09:00, on weekdays\n" {
+		t.Errorf("describe = %q, exit %d", out, code)
+	}
+
+	out, _, code = runCLI(t, "describe", "--locale", "de", "0 9 * * 1-5")
+	if code != exitOK || out != "Um 09:00, an Werktagen\n" {
+		t.Errorf("describe --locale de = %q, exit %d", out, code)
+	}
+}
+
+func TestNextAndPrev(t *testing.T) {
+	out, _, code := runCLI(t, "next", "-n", "2", "--tz", "America/New_York", "--from", "2024-01-01T00:00:00Z", "0 9 * * 1#2")
+	want := "Mon 2024-01-08 09:00:00 EST\nMon 2024-02-12 09:00:00 EST\n"
+	if code != exitOK || out != want {
+		t.Errorf("next = %q, exit %d, want %q", out, code, want)
+	}
+
+	out, _, code = runCLI(t, "prev", "-n", "1", "--json", "--from", "2024-01-01T00:00:00Z", "@daily")
+	var got struct {
+		Timezone string
+		Times    []string
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil || code != exitOK {
+		t.Fatalf("prev --json = %q, exit %d, err %v", out, code, err)
+	}
+	if got.Timezone != "UTC" || len(got.Times) != 1 || got.Times[0] != "2023-12-31T00:00:00Z" {
+		t.Errorf("prev --json = %+v", got)
+	}
+
+	_, _, code = runCLI(t, "next", "--tz", "Mars/Olympus", "* * * * *")
+	if code != exitUsage {
+		t.Errorf("next with invalid timezone exit = %d, want %d", code, exitUsage)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	out, _, code := runCLI(t, "explain", "*/15 9-17 L * MON-FRI")
+	if code != exitOK {
+		t.Fatalf("explain exit = %d", code)
+	}
+	for _, want := range []string{
+		"minute        */15       0,15,30,45",
+		"hour          9-17       9-17",
+		"day-of-month  L          special L",
+		"day-of-week   MON-FRI    1-5",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("explain output missing %q:\n%s", want, out)
+		}
+	}
+
+	out, _, code = runCLI(t, "explain", "--json", "@daily")
+	var got struct {
+		Expression string
+		Fields     []map[string]any
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil || code != exitOK {
+		t.Fatalf("explain --json = %q, exit %d, err %v", out, code, err)
+	}
+	if got.Expression != "@daily" || got.Fields[2]["name"] != "day-of-month" {
+		t.Errorf("explain --json = %s", out)
+	}
+	if _, ok := got.Fields[2]["special"]; ok {
+		t.Errorf("explain --json has special for a field without rules: %s", out)
+	}
+
+	out, _, _ = runCLI(t, "explain", "--json", "0 9 L-1 * *")
+	if !strings.Contains(out, `"special": [`) || !strings.Contains(out, `"L-1"`) {
+		t.Errorf("explain --json missing L-1 special token: %s", out)
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	if _, _, code := runCLI(t); code != exitUsage {
+		t.Errorf("no arguments exit = %d, want %d", code, exitUsage)
+	}
+	if _, _, code := runCLI(t, "bogus"); code != exitUsage {
+		t.Errorf("unknown command exit = %d, want %d", code, exitUsage)
+	}
+	if _, _, code := runCLI(t, "describe"); code != exitUsage {
+		t.Errorf("missing expression exit = %d, want %d", code, exitUsage)
+	}
+}
+
+func TestCal(t *testing.T) {
+	out, _, code := runCLI(t, "cal", "--from", "2024-01", "--months", "2", "0 9 * * 1#2")
+	if code != exitOK {
+		t.Fatalf("cal exit = %d", code)
+	}
+	for _, want := range []string{"January 2024", " 7  8* 9 10", "February 2024", "Mon Feb 12     1 run   9:00 AM"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("cal output missing %q:\n%s", want, out)
+		}
+	}
+
+	out, _, code = runCLI(t, "cal", "--json", "--from", "2024-02", "--tz", "Europe/Paris", "0 0 L * *")
+	var got struct {
+		Days []struct {
+			Date  string
+			Count int
+			First string
+		}
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil || code != exitOK {
+		t.Fatalf("cal --json = %q, exit %d, err %v", out, code, err)
+	}
+	if len(got.Days) != 1 || got.Days[0].Date != "2024-02-29" || got.Days[0].First != "2024-02-29T00:00:00+01:00" {
+		t.Errorf("cal --json days = %+v", got.Days)
+	}
+}
+
+func TestLint(t *testing.T) {
+	out, _, code := runCLI(t, "lint", "* 9 * * *")
+	if code != exitOK {
+		t.Errorf("lint with warning exit = %d, want %d", code, exitOK)
+	}
+	for _, want := range []string{"warning[wildcard-minute]", "fix:        0 9 * * *"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("lint output missing %q:\n%s", want, out)
+		}
+	}
+
+	if _, _, code := runCLI(t, "lint", "--fail-on", "warning", "* 9 * * *"); code != exitInvalid {
+		t.Errorf("lint --fail-on warning exit = %d, want %d", code, exitInvalid)
+	}
+	if _, _, code := runCLI(t, "lint", "0 0 30 2 *"); code != exitInvalid {
+		t.Errorf("lint never-runs exit = %d, want %d", code, exitInvalid)
+	}
+}
+
+func TestLint_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "crontab")
+	src := "# jobs\n*/7 * * * * poll\n0 9 * * * report\n61 * * * * broken\n"
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out, _, code := runCLI(t, "lint", "--json", "--file", path)
+	if code != exitInvalid {
+		t.Errorf("lint --file exit = %d, want %d", code, exitInvalid)
+	}
+
+	var got []struct {
+		Line        int
+		Diagnostics []struct{ Code string }
+		Error       *struct{ Field string }
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", out, err)
+	}
+	if len(got) != 3 || got[0].Line != 2 || len(got[0].Diagnostics) != 1 || got[0].Diagnostics[0].Code != "uneven-step" {
+		t.Fatalf("lint --file = %+v", got)
+	}
+	if len(got[1].Diagnostics) != 0 || got[2].Error == nil || got[2].Error.Field != "minute" {
+		t.Errorf("lint --file = %+v", got)
+	}
+}
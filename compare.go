@@ -0,0 +1,86 @@
+// compare.go - Semantic comparison of expressions
+
+package expressparser
+
+import "time"
+
+// Day matching depends only on the month, its length and the weekday it
+// starts on. The Gregorian calendar repeats those every 28 years between
+// 1901 and 2099, so comparing matches over one such cycle compares them for
+// every month.
+const (
+	cycleStartYear = 2000
+	cycleYears     = 28
+)
+
+// Equal reports whether e and other fire at exactly the same instants
+//
+// Fields are compared by their expanded values rather than their text, and
+// day-of-month and day-of-week rules, including L, W, # and the OR between
+// the two day fields, are compared by the days they select. Two expressions
+// that never fire are equal.
+//
+// Example:
+//
+//	a := expressparser.MustParse("0 9 * * MON-FRI")
+//	b := expressparser.MustParse("0 0 9 ? * 1,2,3,4,5")
+//	a.Equal(b) // true
+func (e *Expression) Equal(other *Expression) bool {
+	return e.Subsumes(other) && other.Subsumes(e)
+}
+
+// Subsumes reports whether e fires at every instant other fires at, so that
+// other is redundant next to e
+//
+// An expression that never fires is subsumed by every expression.
+//
+// Example:
+//
+//	hourly := expressparser.MustParse("0 * * * *")
+//	hourly.Subsumes(expressparser.MustParse("0 9-17 * * 1-5")) // true
+func (e *Expression) Subsumes(other *Expression) bool {
+	otherDays := other.cycleDays()
+	if len(otherDays) == 0 {
+		return true
+	}
+
+	if !fieldSubsumes(e.Second, other.Second) ||
+		!fieldSubsumes(e.Minute, other.Minute) ||
+		!fieldSubsumes(e.Hour, other.Hour) {
+		return false
+	}
+
+	days := e.cycleDays()
+	for d := range otherDays {
+		if !days[d] {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldSubsumes reports whether a contains every value of b
+func fieldSubsumes(a, b *Field) bool {
+	for v := range b.Values {
+		if !a.Values[v] {
+			return false
+		}
+	}
+	return true
+}
+
+// cycleDays returns the days of the 28-year cycle on which the expression
+// fires, as offsets from the first day of the cycle
+func (e *Expression) cycleDays() map[int]bool {
+	s := NewScheduler(e, WithLocation(time.UTC))
+	days := make(map[int]bool)
+
+	start := time.Date(cycleStartYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(cycleYears, 0, 0)
+	for t, i := start, 0; t.Before(end); t, i = t.AddDate(0, 0, 1), i+1 {
+		if e.Month.Contains(int(t.Month())) && s.matchesDay(t) {
+			days[i] = true
+		}
+	}
+	return days
+}
@@ -0,0 +1,69 @@
+// compare_test.go - Tests for semantic comparison of expressions
+
+package expressparser
+
+import "testing"
+
+func TestExpression_Equal(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"* * * * *", "0-59 * * * *", true},
+		{"0 9 * * MON-FRI", "0 0 9 ? * 1,2,3,4,5", true},
+		{"*/15 * * * *", "0,15,30,45 * * * *", true},
+		{"@daily", "0 0 * * *", true},
+		{"0 0 * * *", "0 0 1-31 * *", true},
+		{"0 0 * * *", "0 0 * * 0-6", true},
+		{"0 0 L 2 *", "0 0 28,29 2 *", false},
+		{"0 0 L 4,6,9,11 *", "0 0 30 4,6,9,11 *", true},
+		{"0 0 * * 1#1", "0 0 1-7 * *", false},
+		{"0 0 * * 5L", "0 0 * * 5#5", false},
+		{"0 0 30 2 *", "0 12 31 4 *", true}, // neither ever runs
+		{"0 9 * * *", "0 10 * * *", false},
+		{"0 9 * * *", "30 0 9 * * *", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			a, b := MustParse(tt.a), MustParse(tt.b)
+			if got := a.Equal(b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := b.Equal(a); got != tt.want {
+				t.Errorf("reversed Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpression_Subsumes(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"* * * * *", "0 9 * * 1-5", true},
+		{"0 * * * *", "0 9-17 * * 1-5", true},
+		{"0 9-17 * * 1-5", "0 * * * *", false},
+		{"0 0 * * 1", "0 0 * * 1#2", true},
+		{"0 0 * * 1#2", "0 0 * * 1", false},
+		{"0 0 * * 5", "0 0 * * 5L", true},
+		{"0 0 28-31 * *", "0 0 L * *", true},
+		{"0 0 L * *", "0 0 28-31 * *", false},
+		{"0 0 * * 1-5", "0 0 1W * *", true},
+		{"0 0 * * 1-5", "0 0 15W * *", true},
+		{"0 0 1,15 * *", "0 0 1 * 1", false}, // day fields are ORed
+		{"0 0 * * *", "0 0 1 * 1", true},
+		{"0 9 * * *", "0 0 30 2 *", true}, // never runs
+		{"0 0 9 * * *", "0 9 * * *", true},
+		{"0 9 * * *", "*/30 0 9 * * *", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" over "+tt.b, func(t *testing.T) {
+			if got := MustParse(tt.a).Subsumes(MustParse(tt.b)); got != tt.want {
+				t.Errorf("Subsumes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
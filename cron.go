@@ -2,6 +2,7 @@ package expressparser
 
 import (
 	"strings"
+	"unicode"
 )
 
 type ExpressionType int
@@ -22,6 +23,7 @@ type Expression struct {
 	DayOfWeek         *Field
 	HasLastDayOfMonth bool
 	HasLastWeekday    bool
+	HasLastDayOffset  bool
 	HasNearestWeekday bool
 	HasNthDayOfWeek   bool
 	HasLastDayOfWeek  bool
@@ -38,7 +40,8 @@ var predefinedExpressions = map[string]string{
 }
 
 type cronParser struct {
-	seconds bool
+	seconds   bool
+	allErrors bool
 }
 
 type ParserOption func(*cronParser)
@@ -49,27 +52,42 @@ func WithSeconds() ParserOption {
 	}
 }
 
+// WithAllErrors makes the parser check every field and every comma-separated
+// part instead of stopping at the first problem. Field errors are returned
+// together as a *ParseErrors.
+func WithAllErrors() ParserOption {
+	return func(p *cronParser) {
+		p.allErrors = true
+	}
+}
+
 func parseCron(expr string, opts ...ParserOption) (*Expression, error) {
 	parser := &cronParser{seconds: false}
 	for _, opt := range opts {
 		opt(parser)
 	}
 
+	original := expr
 	expr = strings.TrimSpace(expr)
 
 	if expr == "" {
 		return nil, ErrEmptyExpression
 	}
+	lead := strings.Index(original, expr)
 
 	if strings.HasPrefix(expr, "@") {
 		predefined, ok := predefinedExpressions[strings.ToLower(expr)]
 		if !ok {
-			return nil, NewParseError(expr, "", "", "unknown predefined expression")
+			err := NewParseError(expr, "", expr, "unknown predefined expression")
+			err.Offset, err.Length = lead, len(expr)
+			err.Suggestion = suggest(strings.ToLower(expr), predefinedExpressions)
+			return nil, err
 		}
 		expr = predefined
+		lead = -1
 	}
 
-	fields := strings.Fields(expr)
+	fields, offsets := splitFields(expr)
 	fieldCount := len(fields)
 
 	if fieldCount < 5 || fieldCount > 6 {
@@ -78,61 +96,75 @@ func parseCron(expr string, opts ...ParserOption) (*Expression, error) {
 
 	result := &Expression{Raw: expr}
 
-	var secondExpr, minuteExpr, hourExpr, domExpr, monthExpr, dowExpr string
-
-	if fieldCount == 6 {
-		result.Type = ExtendedCron
-		secondExpr = fields[0]
-		minuteExpr = fields[1]
-		hourExpr = fields[2]
-		domExpr = fields[3]
-		monthExpr = fields[4]
-		dowExpr = fields[5]
-	} else {
+	// Default seconds field for 5-field expressions
+	if fieldCount == 5 {
 		result.Type = StandardCron
-		secondExpr = "0"
-		minuteExpr = fields[0]
-		hourExpr = fields[1]
-		domExpr = fields[2]
-		monthExpr = fields[3]
-		dowExpr = fields[4]
+		fields = append([]string{"0"}, fields...)
+		offsets = append([]int{-1}, offsets...)
+	} else {
+		result.Type = ExtendedCron
 	}
 
-	var err error
-
-	result.Second, err = NewFieldParser(FieldSecond).Parse(secondExpr)
-	if err != nil {
-		return nil, err
+	targets := []struct {
+		fieldType FieldType
+		dest      **Field
+	}{
+		{FieldSecond, &result.Second},
+		{FieldMinute, &result.Minute},
+		{FieldHour, &result.Hour},
+		{FieldDayOfMonth, &result.DayOfMonth},
+		{FieldMonth, &result.Month},
+		{FieldDayOfWeek, &result.DayOfWeek},
 	}
 
-	result.Minute, err = NewFieldParser(FieldMinute).Parse(minuteExpr)
-	if err != nil {
-		return nil, err
+	var errs []error
+	for i, target := range targets {
+		field, fieldErrs := NewFieldParser(target.fieldType).parse(fields[i], parser.allErrors)
+		for _, err := range fieldErrs {
+			// Report positions against the caller's string
+			if lead >= 0 && offsets[i] >= 0 {
+				err = shiftError(err, lead+offsets[i])
+			}
+			errs = append(errs, err)
+		}
+		if len(errs) > 0 && !parser.allErrors {
+			return nil, errs[0]
+		}
+		*target.dest = field
 	}
-
-	result.Hour, err = NewFieldParser(FieldHour).Parse(hourExpr)
-	if err != nil {
-		return nil, err
+	if len(errs) > 0 {
+		return nil, &ParseErrors{Expression: original, Errors: errs}
 	}
 
-	result.DayOfMonth, err = NewFieldParser(FieldDayOfMonth).Parse(domExpr)
-	if err != nil {
-		return nil, err
-	}
+	result.detectSpecialFlags()
 
-	result.Month, err = NewFieldParser(FieldMonth).Parse(monthExpr)
-	if err != nil {
-		return nil, err
-	}
+	return result, nil
+}
 
-	result.DayOfWeek, err = NewFieldParser(FieldDayOfWeek).Parse(dowExpr)
-	if err != nil {
-		return nil, err
+// splitFields splits s around runs of whitespace like strings.Fields, also
+// returning the byte offset of each field in s
+func splitFields(s string) ([]string, []int) {
+	var fields []string
+	var offsets []int
+
+	start := -1
+	for i, r := range s {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				fields = append(fields, s[start:i])
+				offsets = append(offsets, start)
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, s[start:])
+		offsets = append(offsets, start)
 	}
 
-	result.detectSpecialFlags()
-
-	return result, nil
+	return fields, offsets
 }
 
 func (e *Expression) detectSpecialFlags() {
@@ -140,9 +172,12 @@ func (e *Expression) detectSpecialFlags() {
 		if v == 32 {
 			e.HasLastDayOfMonth = true
 		}
-		if v == 33 {
+		if v == lastWeekdayValue {
 			e.HasLastWeekday = true
 		}
+		if v > 32 && v < lastWeekdayValue {
+			e.HasLastDayOffset = true
+		}
 		if v >= 101 && v <= 131 {
 			e.HasNearestWeekday = true
 		}
@@ -159,7 +194,7 @@ func (e *Expression) detectSpecialFlags() {
 }
 
 func (e *Expression) HasSpecialDayHandling() bool {
-	return e.HasLastDayOfMonth || e.HasLastWeekday || e.HasNearestWeekday ||
+	return e.HasLastDayOfMonth || e.HasLastWeekday || e.HasLastDayOffset || e.HasNearestWeekday ||
 		e.HasNthDayOfWeek || e.HasLastDayOfWeek
 }
 
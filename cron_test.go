@@ -3,6 +3,8 @@
 package expressparser
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -366,6 +368,121 @@ func TestParseCron_InvalidExpressions(t *testing.T) {
 	}
 }
 
+func TestParseCron_ErrorPositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		expr       string
+		span       string // expected text under [start, end)
+		suggestion string
+	}{
+		{name: "out of range minute", expr: "61 * * * *", span: "61"},
+		{name: "leading whitespace", expr: "  0 25 * * *", span: "25"},
+		{name: "list element", expr: "0 9 1,5,40 * *", span: "40"},
+		{name: "range end", expr: "0 9-24 * * *", span: "24"},
+		{name: "reversed range", expr: "0 17-9 * * *", span: "17-9"},
+		{name: "zero step", expr: "*/0 * * * *", span: "0"},
+		{name: "six-field expression", expr: "0 0 0 * * MONN", span: "MONN", suggestion: "MON"},
+		{name: "misspelt month", expr: "0 0 1 JANU *", span: "JANU", suggestion: "JAN"},
+		{name: "misspelt day in nth", expr: "0 0 * * TUES#2", span: "TUES", suggestion: "TUE"},
+		{name: "misspelt macro", expr: " @dialy", span: "@dialy", suggestion: "@daily"},
+		{name: "lowercase name", expr: "0 0 * * frj", span: "frj", suggestion: "FRI"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseCron(tt.expr)
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+
+			spanner, ok := err.(interface{ Span() (int, int, bool) })
+			if !ok {
+				t.Fatalf("error %T does not report a span", err)
+			}
+			start, end, known := spanner.Span()
+			if !known {
+				t.Fatalf("span unknown for %v", err)
+			}
+			if got := tt.expr[start:end]; got != tt.span {
+				t.Errorf("span = %q, want %q", got, tt.span)
+			}
+
+			var suggestion string
+			switch e := err.(type) {
+			case *FieldError:
+				suggestion = e.Suggestion
+			case *ParseError:
+				suggestion = e.Suggestion
+			}
+			if suggestion != tt.suggestion {
+				t.Errorf("suggestion = %q, want %q", suggestion, tt.suggestion)
+			}
+		})
+	}
+}
+
+func TestParseCron_SuggestionInMessage(t *testing.T) {
+	_, err := parseCron("0 9 * * MONN")
+	if err == nil || !strings.Contains(err.Error(), `did you mean "MON"?`) {
+		t.Errorf("error = %v, want did-you-mean hint", err)
+	}
+
+	_, err = parseCron("0 9 * * XYZ")
+	if err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("error = %v, want no hint for unrelated text", err)
+	}
+}
+
+func TestParseCron_AllErrors(t *testing.T) {
+	expr := "61 25 1,40,50 * FOO"
+	_, err := parseCron(expr, WithAllErrors())
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var all *ParseErrors
+	if !errors.As(err, &all) {
+		t.Fatalf("error %T is not a *ParseErrors", err)
+	}
+
+	type spanner interface {
+		Span() (start, end int, ok bool)
+	}
+	want := []string{"61", "25", "40", "50", "FOO"}
+	if len(all.Errors) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(all.Errors), len(want), err)
+	}
+	for i, e := range all.Errors {
+		var sp spanner
+		if !errors.As(e, &sp) {
+			t.Errorf("error %d (%v) has no span", i, e)
+			continue
+		}
+		start, end, ok := sp.Span()
+		if !ok || expr[start:end] != want[i] {
+			t.Errorf("error %d spans %q, want %q", i, expr[start:end], want[i])
+		}
+	}
+
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != FieldMinute {
+		t.Errorf("errors.As(*FieldError) = %v, want the minute error", fieldErr)
+	}
+
+	// Without the option parsing stops at the first problem
+	if _, err := parseCron(expr); errors.As(err, &all) {
+		t.Errorf("default parser returned %T, want a single error", err)
+	}
+
+	// Structural problems are still reported on their own
+	if _, err := ParseAll("0 0 *"); !errors.Is(err, ErrInvalidFieldCount) {
+		t.Errorf("ParseAll(short) = %v, want ErrInvalidFieldCount", err)
+	}
+	if _, err := ParseAll("0 9 * * MON-FRI"); err != nil {
+		t.Errorf("ParseAll(valid) = %v", err)
+	}
+}
+
 func TestParseCron_SpecialDayHandling(t *testing.T) {
 	tests := []struct {
 		name                  string
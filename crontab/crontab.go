@@ -0,0 +1,350 @@
+// Package crontab parses crontab files into schedules built on expressparser.
+//
+// A crontab file consists of comments, blank lines, environment assignments
+// and job entries:
+//
+//	# Send the weekly report
+//	MAILTO=ops@example.com
+//	SHELL=/bin/bash
+//	0 9 * * 1     /usr/local/bin/report --weekly
+//	@reboot       /usr/local/bin/warm-cache
+//
+// System crontabs such as /etc/crontab have an extra user column between the
+// schedule and the command; enable it with WithUserColumn.
+//
+// Parsed files keep every line, so they can be edited and written back with
+// comments, blank lines and alignment intact; see File.WriteTo.
+//
+// Errors are collected per line instead of stopping at the first one, so a
+// single pass reports every problem in the file:
+//
+//	f, err := crontab.Parse(file)
+//	if err != nil {
+//	    log.Fatal(err) // I/O error
+//	}
+//	for _, lineErr := range f.Errors {
+//	    fmt.Println(lineErr)
+//	}
+package crontab
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/SravanKolanu20/expressparser"
+)
+
+// Entry is a job line in a crontab file
+type Entry struct {
+	Line       int                       // 1-based line number
+	Schedule   string                    // Schedule as written: five fields or an @macro
+	Expression *expressparser.Expression // Parsed schedule, nil for @reboot
+	Reboot     bool                      // Entry runs once at startup (@reboot)
+	User       string                    // User column, only set with WithUserColumn
+	Command    string                    // Command to execute
+	Env        map[string]string         // Environment in effect for the entry
+
+	layout entryLayout // Original text and whitespace, used when re-rendering
+}
+
+// File is a parsed crontab file
+type File struct {
+	Lines   []*Line           // Every line of the file, in order
+	Entries []*Entry          // Valid job entries in file order
+	Env     map[string]string // Every environment assignment in the file
+	Errors  []*LineError      // Lines that could not be parsed
+}
+
+// LineKind classifies a line of a crontab file
+type LineKind int
+
+const (
+	LineBlank   LineKind = iota // Empty or whitespace-only line
+	LineComment                 // Line starting with #
+	LineEnv                     // NAME=value assignment
+	LineEntry                   // Job entry
+	LineInvalid                 // Line that could not be parsed
+)
+
+// Line is a single line of a crontab file
+type Line struct {
+	Number int        // 1-based line number
+	Kind   LineKind   // What the line contains
+	Text   string     // Line content without the line terminator
+	Entry  *Entry     // Parsed entry for LineEntry
+	Err    *LineError // Parse error for LineInvalid
+
+	raw string // Original bytes including the line terminator
+}
+
+// Err returns the line errors joined into a single error, or nil
+func (f *File) Err() error {
+	errs := make([]error, len(f.Errors))
+	for i, e := range f.Errors {
+		errs[i] = e
+	}
+	return errors.Join(errs...)
+}
+
+// LineError is an error on a specific line of a crontab file
+type LineError struct {
+	Line int    // 1-based line number
+	Text string // The offending line
+	Err  error  // Underlying error, often an expressparser parse error
+}
+
+// Error implements the error interface
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// Sentinel errors reported in LineError.Err
+var (
+	// ErrMissingCommand is returned when an entry has a schedule but no command
+	ErrMissingCommand = errors.New("missing command")
+
+	// ErrMissingUser is returned when the user column is enabled but absent
+	ErrMissingUser = errors.New("missing user")
+)
+
+// Errors returned by Entry.SetExpression
+var (
+	// ErrNilExpression is returned when the expression is nil
+	ErrNilExpression = errors.New("expression cannot be nil")
+
+	// ErrSecondsField is returned for six-field expressions, which crontab
+	// cannot parse
+	ErrSecondsField = errors.New("crontab entries cannot have a seconds field")
+)
+
+type parser struct {
+	userColumn bool
+}
+
+// Option configures parsing
+type Option func(*parser)
+
+// WithUserColumn expects a user name between the schedule and the command,
+// as in /etc/crontab and /etc/cron.d files
+func WithUserColumn() Option {
+	return func(p *parser) {
+		p.userColumn = true
+	}
+}
+
+// Parse reads a crontab file from r
+//
+// The returned error is only non-nil if reading r fails; problems with
+// individual lines are collected in File.Errors.
+func Parse(r io.Reader, opts ...Option) (*File, error) {
+	p := &parser{}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	f := &File{Env: make(map[string]string)}
+
+	br := bufio.NewReader(r)
+	lineNo := 0
+	for {
+		raw, readErr := br.ReadString('\n')
+		if readErr != nil && readErr != io.EOF {
+			return nil, readErr
+		}
+		if raw == "" {
+			break
+		}
+		lineNo++
+
+		text := strings.TrimSuffix(strings.TrimSuffix(raw, "\n"), "\r")
+		line := &Line{Number: lineNo, Text: text, raw: raw}
+		f.Lines = append(f.Lines, line)
+
+		entry, name, value, err := p.parseLine(text)
+		switch {
+		case err != nil:
+			line.Kind = LineInvalid
+			line.Err = &LineError{Line: lineNo, Text: text, Err: err}
+			f.Errors = append(f.Errors, line.Err)
+		case entry != nil:
+			line.Kind = LineEntry
+			line.Entry = entry
+			entry.Line = lineNo
+			entry.Env = copyEnv(f.Env)
+			f.Entries = append(f.Entries, entry)
+		case name != "":
+			line.Kind = LineEnv
+			f.Env[name] = value
+		case strings.TrimSpace(text) == "":
+			line.Kind = LineBlank
+		default:
+			line.Kind = LineComment
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+	}
+
+	return f, nil
+}
+
+// ParseString parses a crontab file held in a string
+func ParseString(s string, opts ...Option) (*File, error) {
+	return Parse(strings.NewReader(s), opts...)
+}
+
+// parseLine classifies a single line. It returns an entry for job lines, a
+// name and value for environment assignments, and nothing for blank lines
+// and comments.
+func (p *parser) parseLine(text string) (entry *Entry, name, value string, err error) {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return nil, "", "", nil
+	}
+
+	if name, value, ok := parseEnv(trimmed); ok {
+		return nil, name, value, nil
+	}
+
+	entry, err = p.parseEntry(text)
+	return entry, "", "", err
+}
+
+// parseEntry parses a job line: schedule, optional user and command
+func (p *parser) parseEntry(text string) (*Entry, error) {
+	entry := &Entry{}
+
+	line := strings.TrimLeft(text, " \t")
+	entry.layout.indent = text[:len(text)-len(line)]
+
+	var rest string
+	if strings.HasPrefix(line, "@") {
+		macro, tail := cutField(line)
+		entry.Schedule = macro
+		rest = tail
+		if strings.EqualFold(macro, "@reboot") {
+			entry.Reboot = true
+		} else {
+			expr, err := expressparser.Parse(macro)
+			if err != nil {
+				return nil, err
+			}
+			entry.Expression = expr
+		}
+	} else {
+		fields := make([]string, 0, 5)
+		rest = line
+		for len(fields) < 5 && rest != "" {
+			var field string
+			field, rest = cutField(rest)
+			fields = append(fields, field)
+		}
+		if len(fields) < 5 {
+			return nil, expressparser.ErrInvalidFieldCount
+		}
+		entry.Schedule = strings.Join(fields, " ")
+		expr, err := expressparser.Parse(entry.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		entry.Expression = expr
+	}
+	entry.layout.scheduleEnd = len(text) - len(rest)
+	entry.layout.gap = gapBefore(text, entry.layout.scheduleEnd)
+
+	if p.userColumn {
+		entry.User, rest = cutField(rest)
+		if entry.User == "" {
+			return nil, ErrMissingUser
+		}
+		entry.layout.userGap = gapBefore(text, len(text)-len(rest))
+	}
+
+	entry.Command = strings.TrimRight(rest, " \t")
+	entry.layout.trailing = rest[len(entry.Command):]
+	if entry.Command == "" {
+		return nil, ErrMissingCommand
+	}
+
+	entry.layout.schedule = entry.Schedule
+	entry.layout.expression = entry.Expression
+	entry.layout.user = entry.User
+	entry.layout.command = entry.Command
+
+	return entry, nil
+}
+
+// gapBefore returns the run of blanks ending at offset end of s
+func gapBefore(s string, end int) string {
+	start := end
+	for start > 0 && (s[start-1] == ' ' || s[start-1] == '\t') {
+		start--
+	}
+	return s[start:end]
+}
+
+// cutField splits off the first whitespace-separated field of s and returns
+// it along with the remainder, with leading whitespace removed
+func cutField(s string) (field, rest string) {
+	s = strings.TrimLeft(s, " \t")
+	i := strings.IndexAny(s, " \t")
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], strings.TrimLeft(s[i:], " \t")
+}
+
+// parseEnv recognises NAME=value assignments. Values may be wrapped in
+// matching single or double quotes, which are removed.
+func parseEnv(line string) (name, value string, ok bool) {
+	name, value, found := strings.Cut(line, "=")
+	if !found {
+		return "", "", false
+	}
+	name = strings.TrimSpace(name)
+	if !isEnvName(name) {
+		return "", "", false
+	}
+
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 {
+		if q := value[0]; (q == '"' || q == '\'') && value[len(value)-1] == q {
+			value = value[1 : len(value)-1]
+		}
+	}
+	return name, value, true
+}
+
+// isEnvName reports whether s is a valid environment variable name
+func isEnvName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func copyEnv(env map[string]string) map[string]string {
+	c := make(map[string]string, len(env))
+	for k, v := range env {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,127 @@
+package crontab
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SravanKolanu20/expressparser"
+)
+
+func TestParse_UserCrontab(t *testing.T) {
+	src := `# Weekly report
+MAILTO=ops@example.com
+SHELL = "/bin/bash"
+
+0 9 * * 1     /usr/local/bin/report --weekly   > /dev/null
+@reboot /usr/local/bin/warm-cache
+PATH='/usr/bin:/bin'
+@daily  backup.sh
+`
+	f, err := ParseString(src)
+	if err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+	if len(f.Errors) != 0 {
+		t.Fatalf("Errors = %v, want none", f.Errors)
+	}
+	if len(f.Entries) != 3 {
+		t.Fatalf("len(Entries) = %d, want 3", len(f.Entries))
+	}
+
+	report := f.Entries[0]
+	if report.Line != 5 || report.Schedule != "0 9 * * 1" || report.Command != "/usr/local/bin/report --weekly   > /dev/null" {
+		t.Errorf("report entry = %+v", report)
+	}
+	if report.Expression == nil || !report.Expression.DayOfWeek.Contains(1) {
+		t.Errorf("report expression = %v, want Mondays", report.Expression)
+	}
+	if report.Env["MAILTO"] != "ops@example.com" || report.Env["SHELL"] != "/bin/bash" {
+		t.Errorf("report env = %v", report.Env)
+	}
+	if _, ok := report.Env["PATH"]; ok {
+		t.Errorf("report env contains PATH assigned after the entry")
+	}
+
+	reboot := f.Entries[1]
+	if !reboot.Reboot || reboot.Expression != nil || reboot.Command != "/usr/local/bin/warm-cache" {
+		t.Errorf("reboot entry = %+v", reboot)
+	}
+
+	daily := f.Entries[2]
+	if daily.Schedule != "@daily" || daily.Expression == nil || daily.Env["PATH"] != "/usr/bin:/bin" {
+		t.Errorf("daily entry = %+v", daily)
+	}
+
+	if len(f.Env) != 3 {
+		t.Errorf("Env = %v, want 3 variables", f.Env)
+	}
+}
+
+func TestParse_SystemCrontab(t *testing.T) {
+	src := "17 *\t* * *\troot    cd / && run-parts --report /etc/cron.hourly\n" +
+		"@weekly backup /usr/bin/backup\n" +
+		"0 0 * * *\n"
+
+	f, err := ParseString(src, WithUserColumn())
+	if err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+
+	if len(f.Entries) != 2 {
+		t.Fatalf("len(Entries) = %d, want 2", len(f.Entries))
+	}
+	if e := f.Entries[0]; e.User != "root" || e.Command != "cd / && run-parts --report /etc/cron.hourly" {
+		t.Errorf("entry = %+v", e)
+	}
+	if e := f.Entries[1]; e.User != "backup" || e.Command != "/usr/bin/backup" {
+		t.Errorf("entry = %+v", e)
+	}
+
+	if len(f.Errors) != 1 || !errors.Is(f.Errors[0], ErrMissingUser) {
+		t.Errorf("Errors = %v, want missing user on line 3", f.Errors)
+	}
+}
+
+func TestParse_CollectsLineErrors(t *testing.T) {
+	src := `61 * * * * too-late
+* * * *
+0 0 * * * ok
+@sometimes cmd
+0 0 * * *
+`
+	f, err := ParseString(src)
+	if err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+
+	if len(f.Entries) != 1 || f.Entries[0].Line != 3 {
+		t.Errorf("Entries = %+v, want only line 3", f.Entries)
+	}
+
+	wantLines := []int{1, 2, 4, 5}
+	if len(f.Errors) != len(wantLines) {
+		t.Fatalf("Errors = %v, want %d errors", f.Errors, len(wantLines))
+	}
+	for i, line := range wantLines {
+		if f.Errors[i].Line != line {
+			t.Errorf("Errors[%d].Line = %d, want %d", i, f.Errors[i].Line, line)
+		}
+	}
+
+	if !expressparser.IsFieldError(f.Errors[0]) {
+		t.Errorf("Errors[0] = %v, want wrapped FieldError", f.Errors[0])
+	}
+	if !errors.Is(f.Errors[1], expressparser.ErrInvalidFieldCount) {
+		t.Errorf("Errors[1] = %v, want ErrInvalidFieldCount", f.Errors[1])
+	}
+	if !expressparser.IsParseError(f.Errors[2]) {
+		t.Errorf("Errors[2] = %v, want wrapped ParseError", f.Errors[2])
+	}
+	if !errors.Is(f.Errors[3], ErrMissingCommand) {
+		t.Errorf("Errors[3] = %v, want ErrMissingCommand", f.Errors[3])
+	}
+
+	if err := f.Err(); !errors.Is(err, ErrMissingCommand) {
+		t.Errorf("Err() = %v, want joined line errors", err)
+	}
+}
@@ -0,0 +1,119 @@
+package crontab
+
+import (
+	"io"
+	"strings"
+
+	"github.com/SravanKolanu20/expressparser"
+)
+
+// entryLayout remembers how an entry was written so that an edited entry can
+// be re-rendered in the same style
+type entryLayout struct {
+	indent      string // Whitespace before the schedule
+	scheduleEnd int    // Offset of the column following the schedule
+	gap         string // Whitespace between schedule and user or command
+	userGap     string // Whitespace between user and command
+	trailing    string // Whitespace after the command
+
+	// Values as parsed, used to detect modifications
+	schedule   string
+	expression *expressparser.Expression
+	user       string
+	command    string
+}
+
+// SetExpression replaces the entry's schedule
+//
+// The schedule is rendered with Expression.String when the file is written.
+// expr must be a five-field expression, since crontab has no seconds field;
+// otherwise the entry is left unchanged and an error is returned.
+func (e *Entry) SetExpression(expr *expressparser.Expression) error {
+	switch {
+	case expr == nil:
+		return ErrNilExpression
+	case expr.IsExtended():
+		return ErrSecondsField
+	}
+	e.Expression = expr
+	e.Schedule = expr.String()
+	e.Reboot = false
+	return nil
+}
+
+// SetCommand replaces the entry's command
+func (e *Entry) SetCommand(command string) {
+	e.Command = command
+}
+
+// Modified reports whether the entry differs from the line it was parsed from
+func (e *Entry) Modified() bool {
+	l := e.layout
+	return e.Schedule != l.schedule || e.Expression != l.expression ||
+		e.User != l.user || e.Command != l.command
+}
+
+// render formats a modified entry, keeping the original indentation and, when
+// the columns were aligned with spaces, the column at which the next field
+// started
+func (e *Entry) render() string {
+	l := e.layout
+
+	schedule := e.Schedule
+	if schedule == l.schedule && e.Expression != l.expression && e.Expression != nil {
+		schedule = e.Expression.String()
+	}
+
+	gap := l.gap
+	if gap == "" || !strings.Contains(gap, "\t") {
+		width := l.scheduleEnd - len(l.indent) - len(schedule)
+		if width < 1 {
+			width = 1
+		}
+		gap = strings.Repeat(" ", width)
+	}
+
+	var b strings.Builder
+	b.WriteString(l.indent)
+	b.WriteString(schedule)
+	b.WriteString(gap)
+	if e.User != "" {
+		b.WriteString(e.User)
+		if l.userGap != "" {
+			b.WriteString(l.userGap)
+		} else {
+			b.WriteByte(' ')
+		}
+	}
+	b.WriteString(e.Command)
+	b.WriteString(l.trailing)
+	return b.String()
+}
+
+// WriteTo writes the file back out
+//
+// Lines other than modified entries are written byte-for-byte as they were
+// read, including their line terminators. Modified entries are re-rendered
+// with their original indentation and line terminator.
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	for _, line := range f.Lines {
+		out := line.raw
+		if line.Kind == LineEntry && line.Entry.Modified() {
+			out = line.Entry.render() + line.raw[len(line.Text):]
+		}
+		n, err := io.WriteString(w, out)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// String returns the file as it would be written by WriteTo
+func (f *File) String() string {
+	var b strings.Builder
+	f.WriteTo(&b)
+	return b.String()
+}
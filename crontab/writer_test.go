@@ -0,0 +1,94 @@
+package crontab
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SravanKolanu20/expressparser"
+)
+
+func TestWriteTo_RoundTrip(t *testing.T) {
+	src := "# header comment  \r\n" +
+		"MAILTO = \"ops@example.com\"\r\n" +
+		"\n" +
+		"  0 9 * * 1     report --weekly  \n" +
+		"*/5\t*\t*\t*\t*\tpoll\n" +
+		"not a valid line\n" +
+		"@reboot warm-cache"
+
+	f, err := ParseString(src)
+	if err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+	if got := f.String(); got != src {
+		t.Errorf("round trip changed the file:\ngot:  %q\nwant: %q", got, src)
+	}
+}
+
+func TestWriteTo_ModifiedEntry(t *testing.T) {
+	src := "# jobs\n" +
+		"0 9 * * 1        report --weekly\n" +
+		"*/15 * * * *     poll\n" +
+		"@daily\t\tbackup\n"
+
+	f, err := ParseString(src)
+	if err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+
+	if err := f.Entries[0].SetExpression(expressparser.MustParse("30 10 * * 1-5")); err != nil {
+		t.Fatalf("SetExpression() error = %v", err)
+	}
+	if err := f.Entries[2].SetExpression(expressparser.MustParse("0 3 * * *")); err != nil {
+		t.Fatalf("SetExpression() error = %v", err)
+	}
+
+	want := "# jobs\n" +
+		"30 10 * * 1-5    report --weekly\n" +
+		"*/15 * * * *     poll\n" +
+		"0 3 * * *\t\tbackup\n"
+	if got := f.String(); got != want {
+		t.Errorf("String() =\n%q\nwant\n%q", got, want)
+	}
+	if f.Entries[1].Modified() {
+		t.Errorf("untouched entry reported as modified")
+	}
+}
+
+func TestWriteTo_LongerScheduleAndUser(t *testing.T) {
+	src := "0 * * * * root   run-parts /etc/cron.hourly\n"
+
+	f, err := ParseString(src, WithUserColumn())
+	if err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+
+	e := f.Entries[0]
+	if err := e.SetExpression(expressparser.MustParse("0,15,30,45 * * * *")); err != nil {
+		t.Fatalf("SetExpression() error = %v", err)
+	}
+	e.SetCommand("run-parts --report /etc/cron.hourly")
+
+	want := "0,15,30,45 * * * * root   run-parts --report /etc/cron.hourly\n"
+	if got := f.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestSetExpression_Invalid(t *testing.T) {
+	f, err := ParseString("0 9 * * * report\n")
+	if err != nil {
+		t.Fatalf("ParseString() error = %v", err)
+	}
+	e := f.Entries[0]
+
+	if err := e.SetExpression(nil); !errors.Is(err, ErrNilExpression) {
+		t.Errorf("SetExpression(nil) error = %v, want ErrNilExpression", err)
+	}
+	if err := e.SetExpression(expressparser.MustParse("30 0 9 * * *")); !errors.Is(err, ErrSecondsField) {
+		t.Errorf("SetExpression(six fields) error = %v, want ErrSecondsField", err)
+	}
+	if e.Modified() || f.String() != "0 9 * * * report\n" {
+		t.Errorf("rejected SetExpression modified the entry: %q", f.String())
+	}
+}
@@ -3,8 +3,12 @@
 package expressparser
 
 import (
-	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 // DescriptionOptions configures how descriptions are generated
@@ -12,11 +16,37 @@ type DescriptionOptions struct {
 	// Use24HourTime uses 24-hour format instead of 12-hour with AM/PM
 	Use24HourTime bool
 
-	// Verbose generates more detailed descriptions
+	// Verbose spells out every value instead of collapsing values into
+	// ranges, steps and shortcuts such as "weekdays", and always describes
+	// the seconds of six-field expressions
 	Verbose bool
 
-	// Locale for localization (future use)
+	// Locale selects the language of the description, e.g. "de" or "fr-CA";
+	// see RegisterLocale. Unknown or empty locales use English.
 	Locale string
+
+	// Location is the timezone the expression runs in. When set, the
+	// description names it: "At 9:00 AM, on weekdays (America/New_York)".
+	Location *time.Location
+
+	// TimezoneAbbreviation names timezones by abbreviation, such as "EST",
+	// instead of by location name
+	TimezoneAbbreviation bool
+
+	// ViewerLocation adds the equivalent of each time of day in a second
+	// timezone, noting when it falls on the next or previous day:
+	// "At 9:00 PM (11:00 AM Asia/Tokyo, next day)". Times are converted
+	// from Location, or UTC if Location is nil. Hours given as plain
+	// numbers, as in "during hour 9 through 17", are not converted, and
+	// neither are minutes past the hour; when the viewer's timezone is offset
+	// by a fraction of an hour the description ends with that offset, as in
+	// "Every hour (UTC; Asia/Kolkata is 5 hours 30 minutes ahead)".
+	ViewerLocation *time.Location
+
+	// ReferenceTime is the instant whose UTC offsets are used to name and
+	// convert timezones, which matters across daylight saving changes.
+	// The zero value means now.
+	ReferenceTime time.Time
 }
 
 // DefaultDescriptionOptions returns the default description options
@@ -30,8 +60,9 @@ func DefaultDescriptionOptions() DescriptionOptions {
 
 // Descriptor generates human-readable descriptions of cron expressions
 type Descriptor struct {
-	expr *Expression
-	opts DescriptionOptions
+	expr   *Expression
+	opts   DescriptionOptions
+	locale *Locale
 }
 
 // NewDescriptor creates a new descriptor for the given expression
@@ -42,8 +73,9 @@ func NewDescriptor(expr *Expression, opts ...DescriptionOptions) *Descriptor {
 	}
 
 	return &Descriptor{
-		expr: expr,
-		opts: options,
+		expr:   expr,
+		opts:   options,
+		locale: resolveLocale(options.Locale),
 	}
 }
 
@@ -57,12 +89,23 @@ func (d *Descriptor) Describe() string {
 		parts = append(parts, timePart)
 	}
 
-	// Describe day of month
+	// Describe day of month and day of week. When both are restricted a
+	// day matches if either field matches, so they are described together.
 	domPart := d.describeDayOfMonth()
+	dowPart := d.describeDayOfWeek()
+	if domPart != "" && dowPart != "" {
+		domPart = d.locale.phrase(PhraseDayOfMonthOrWeek, domPart, dowPart)
+		dowPart = ""
+	}
 	if domPart != "" {
 		parts = append(parts, domPart)
 	}
 
+	// A month step alone, as in "every 3 months", would read as once a month
+	if domPart == "" && dowPart == "" && d.isMonthStep() {
+		parts = append(parts, d.locale.phrase(PhraseEveryDay))
+	}
+
 	// Describe month
 	monthPart := d.describeMonth()
 	if monthPart != "" {
@@ -70,16 +113,18 @@ func (d *Descriptor) Describe() string {
 	}
 
 	// Describe day of week
-	dowPart := d.describeDayOfWeek()
 	if dowPart != "" {
 		parts = append(parts, dowPart)
 	}
 
 	if len(parts) == 0 {
-		return "Every minute"
+		parts = append(parts, d.locale.phrase(PhraseEveryMinute))
 	}
 
-	result := strings.Join(parts, ", ")
+	result := strings.Join(parts, d.locale.partSeparator())
+	if d.opts.Location != nil || d.opts.ViewerLocation != nil {
+		result = d.locale.phrase(PhraseInTimezone, result, d.timezoneNote())
+	}
 	return capitalizeFirst(result)
 }
 
@@ -88,121 +133,309 @@ func (d *Descriptor) describeTime() string {
 	secondAll := d.expr.Second.IsAll()
 	minuteAll := d.expr.Minute.IsAll()
 	hourAll := d.expr.Hour.IsAll()
+	extended := d.expr.Type == ExtendedCron
+
+	// Six-field expressions describe their seconds unless they are only the
+	// zeroth second, which verbose mode describes too
+	seconds := d.expr.GetSeconds()
+	secondsZero := len(seconds) == 1 && seconds[0] == 0
+	spellSeconds := extended && (d.opts.Verbose || !secondsZero)
 
 	// Every second
-	if secondAll && minuteAll && hourAll && d.expr.Type == ExtendedCron {
-		return "every second"
+	if secondAll && minuteAll && hourAll && extended {
+		return d.locale.phrase(PhraseEverySecond)
+	}
+
+	// Steps and ranges, such as "every 15 minutes, between 9:00 AM and 5:59 PM"
+	if !d.opts.Verbose {
+		if desc, ok := d.describeSteppedTime(); ok {
+			return desc
+		}
 	}
 
 	// Every minute
 	if minuteAll && hourAll {
-		if d.expr.Type == ExtendedCron && !secondAll {
-			seconds := d.expr.GetSeconds()
-			return fmt.Sprintf("at second %s of every minute", d.formatList(seconds))
+		if extended && !secondAll {
+			return d.locale.phrase(PhraseSecondsOfEveryMinute, d.formatList(seconds))
 		}
-		return "every minute"
+		return d.locale.phrase(PhraseEveryMinute)
 	}
 
 	// Every hour at specific minute
 	if hourAll && !minuteAll {
 		minutes := d.expr.GetMinutes()
-		if len(minutes) == 1 {
-			if minutes[0] == 0 {
-				return "every hour"
-			}
-			return fmt.Sprintf("at %d minute(s) past every hour", minutes[0])
+		// Several seconds in the hour would read as every minute of it
+		secondsRepeat := extended && (secondAll || len(seconds) > 1)
+		var desc string
+		switch {
+		case len(minutes) == 1 && minutes[0] == 0 && !secondsRepeat:
+			desc = d.locale.phrase(PhraseEveryHour)
+		case len(minutes) == 1 && minutes[0] != 0:
+			desc = d.locale.phrase(PhraseMinutePastEveryHour, minutes[0])
+		default:
+			desc = d.locale.phrase(PhraseMinutesOfEveryHour, d.formatList(minutes))
+		}
+		if spellSeconds {
+			return d.withSeconds(desc)
 		}
-		return fmt.Sprintf("at minute %s of every hour", d.formatList(minutes))
+		return desc
 	}
 
 	// Specific times
 	hours := d.expr.GetHours()
 	minutes := d.expr.GetMinutes()
-	seconds := d.expr.GetSeconds()
+	_, hourStepped := stepOf(d.expr.Hour, hours)
+
+	// Clock times carry the second when there is exactly one, except that a
+	// single time reads "at 9:00 AM and 30 seconds"
+	if spellSeconds && len(seconds) == 1 && len(minutes) == 1 && (d.opts.Verbose || len(hours) > 1) {
+		times := make([]string, len(hours))
+		for i, h := range hours {
+			times[i] = d.formatTimeSeconds(h, minutes[0], seconds[0])
+		}
+		return d.locale.phrase(PhraseAt, d.locale.join(times))
+	}
 
+	var desc string
+	switch {
 	// Single specific time
-	if len(hours) == 1 && len(minutes) == 1 {
+	case len(hours) == 1 && len(minutes) == 1:
 		timeStr := d.formatTime(hours[0], minutes[0])
-		if d.expr.Type == ExtendedCron && len(seconds) == 1 && seconds[0] != 0 {
-			return fmt.Sprintf("at %s and %d second(s)", timeStr, seconds[0])
+		if extended && !d.opts.Verbose && len(seconds) == 1 && seconds[0] != 0 {
+			return d.locale.phrase(PhraseAtWithSeconds, timeStr, seconds[0])
 		}
-		return fmt.Sprintf("at %s", timeStr)
-	}
+		desc = d.locale.phrase(PhraseAt, timeStr)
 
 	// Multiple specific hours, single minute
-	if len(minutes) == 1 {
+	case len(minutes) == 1:
 		hourStrs := make([]string, len(hours))
 		for i, h := range hours {
 			hourStrs[i] = d.formatTime(h, minutes[0])
 		}
-		return fmt.Sprintf("at %s", strings.Join(hourStrs, ", "))
-	}
+		desc = d.locale.phrase(PhraseAt, d.locale.join(hourStrs))
+
+	// Several minutes of stepped hours, as in "at minute 0 and 30, every 2
+	// hours"
+	case hourStepped && !d.opts.Verbose:
+		steps, _ := d.describeHourSteps(0)
+		desc = d.locale.phrase(PhraseAtMinutes, d.formatList(minutes)) + d.locale.partSeparator() + steps
 
 	// Multiple times
-	if len(hours) <= 3 && len(minutes) <= 3 {
-		return fmt.Sprintf("at minute %s of %s", d.formatList(minutes), d.formatHours(hours))
-	}
+	case len(hours) <= 3 && len(minutes) <= 3:
+		desc = d.locale.phrase(PhraseMinutesOfHours, d.formatList(minutes), d.formatHours(hours))
 
 	// Complex time specification
-	return fmt.Sprintf("at minute %s, during hour %s", d.formatList(minutes), d.formatList(hours))
-}
+	default:
+		desc = d.locale.phrase(PhraseMinutesDuringHours, d.formatList(minutes), d.formatList(hours))
+	}
 
-// describeDayOfMonth generates description for day-of-month field
-func (d *Descriptor) describeDayOfMonth() string {
-	if d.expr.DayOfMonth.IsAll() {
-		return ""
+	if spellSeconds {
+		return d.withSeconds(desc)
 	}
+	return desc
+}
 
-	// Handle special values
-	if d.expr.HasLastDayOfMonth {
-		if d.expr.DayOfMonth.Values[32] {
-			return "on the last day of the month"
+// describeSteppedTime describes times written with steps, such as
+// "*/15 9-17" as "every 15 minutes, between 9:00 AM and 5:59 PM" and
+// "30 */5 9-17" with seconds as "at second 30, every 5 minutes, between
+// 9:00 AM and 5:59 PM", and reports false for times that don't have that
+// shape
+func (d *Descriptor) describeSteppedTime() (string, bool) {
+	seconds := d.expr.GetSeconds()
+	minutes := d.expr.GetMinutes()
+	secondsZero := d.expr.Type != ExtendedCron || (len(seconds) == 1 && seconds[0] == 0)
+
+	switch {
+	case secondsZero:
+		return d.describeMinuteSteps(minutes)
+	case d.expr.Minute.IsAll():
+		desc, ok := d.describeEvery(d.expr.Second, seconds,
+			PhraseEverySecond, PhraseEveryNSeconds, PhraseEveryNSecondsFrom, PhraseSecondRange)
+		if !ok {
+			return "", false
 		}
+		return d.withHourWindow(desc)
 	}
 
-	if d.expr.HasLastWeekday {
-		if d.expr.DayOfMonth.Values[33] {
-			return "on the last weekday of the month"
-		}
+	// Seconds lead the minutes and hours, as in "every 10 seconds, every 5
+	// minutes"
+	if d.expr.Second.IsAll() {
+		return "", false
 	}
 
-	// Handle L-N (offset from last day)
-	for v := range d.expr.DayOfMonth.Values {
-		if v > 32 && v < 100 {
-			offset := v - 32
-			if offset == 1 {
-				return "on the day before the last day of the month"
-			}
-			return fmt.Sprintf("on the %d days before the last day of the month", offset)
-		}
+	// Several seconds of stepped hours would read as every minute of those
+	// hours, so the minute is named: "every 10 seconds, at minute 0, every 2
+	// hours"
+	if _, ok := stepOf(d.expr.Hour, d.expr.GetHours()); ok && len(minutes) == 1 && len(seconds) > 1 {
+		steps, _ := d.describeHourSteps(0)
+		return d.describeSeconds() + d.locale.partSeparator() +
+			d.locale.phrase(PhraseAtMinutes, d.formatList(minutes)) + d.locale.partSeparator() + steps, true
 	}
+	desc, ok := d.describeMinuteSteps(minutes)
+	if !ok {
+		return "", false
+	}
+	return d.describeSeconds() + d.locale.partSeparator() + desc, true
+}
 
-	// Handle NW (nearest weekday)
-	if d.expr.HasNearestWeekday {
-		for v := range d.expr.DayOfMonth.Values {
-			if v >= 101 && v <= 131 {
-				day := v - 100
-				return fmt.Sprintf("on the weekday nearest to day %d of the month", day)
-			}
-		}
+// describeSeconds describes a seconds field that is not a wildcard on its
+// own, as a step or as the seconds it lists
+func (d *Descriptor) describeSeconds() string {
+	seconds := d.expr.GetSeconds()
+	if desc, ok := d.describeEvery(d.expr.Second, seconds,
+		PhraseEverySecond, PhraseEveryNSeconds, PhraseEveryNSecondsFrom, PhraseSecondRange); ok && !d.opts.Verbose {
+		return desc
 	}
+	return d.locale.phrase(PhraseAtSeconds, d.formatList(seconds))
+}
+
+// describeMinuteSteps describes minutes and hours written with steps, and
+// reports false for times that don't have that shape
+func (d *Descriptor) describeMinuteSteps(minutes []int) (string, bool) {
+	if len(minutes) == 1 {
+		return d.describeHourSteps(minutes[0])
+	}
+	desc, ok := d.describeEvery(d.expr.Minute, minutes,
+		PhraseEveryMinute, PhraseEveryNMinutes, PhraseEveryNMinutesFrom, PhraseMinuteRange)
+	if !ok {
+		return "", false
+	}
+	return d.withHourWindow(desc)
+}
+
+// withHourWindow appends the hours to a seconds or minutes description as a
+// window, as in "every 15 minutes, between 9:00 AM and 5:59 PM", and reports
+// false if the hours are not consecutive
+func (d *Descriptor) withHourWindow(desc string) (string, bool) {
+	if d.expr.Hour.IsAll() {
+		return desc, true
+	}
+	hours := d.expr.GetHours()
+	if !isConsecutive(hours) {
+		return "", false
+	}
+	between := d.locale.phrase(PhraseBetween,
+		d.formatTime(hours[0], 0), d.formatTime(hours[len(hours)-1], 59))
+	return desc + d.locale.partSeparator() + between, true
+}
+
+// describeEvery describes a seconds or minutes field that is either a
+// wildcard or a step, and reports false otherwise
+func (d *Descriptor) describeEvery(f *Field, values []int, every, everyN, everyNFrom, valueRange Phrase) (string, bool) {
+	if f.IsAll() {
+		return d.locale.phrase(every), true
+	}
+
+	p, ok := stepOf(f, values)
+	switch {
+	case !ok:
+		return "", false
+	case p.full && p.start == 0:
+		return d.locale.phrase(everyN, p.step), true
+	case p.full:
+		return d.locale.phrase(everyNFrom, p.step, p.start), true
+	}
+	return d.locale.phrase(everyN, p.step) + d.locale.partSeparator() +
+		d.locale.phrase(valueRange, p.start, p.end), true
+}
+
+// describeHourSteps describes hours written with a step at a single minute,
+// such as "0 1/2" as "every 2 hours starting at 1:00 AM", and runs of three
+// or more consecutive hours as a window, such as "0 9-17" as "at minute 0,
+// every hour between 9:00 AM and 5:00 PM"
+func (d *Descriptor) describeHourSteps(minute int) (string, bool) {
+	hours := d.expr.GetHours()
+	p, ok := stepOf(d.expr.Hour, hours)
+	switch {
+	case !ok && len(hours) > 2 && len(hours) < 24 && isConsecutive(hours):
+		return d.locale.phrase(PhraseMinuteOfHoursBetween, minute,
+			d.formatTime(hours[0], minute), d.formatTime(hours[len(hours)-1], minute)), true
+	case !ok:
+		return "", false
+	case p.full && p.start == 0 && minute == 0:
+		return d.locale.phrase(PhraseEveryNHours, p.step), true
+	case p.full:
+		return d.locale.phrase(PhraseEveryNHoursFrom, p.step, d.formatTime(p.start, minute)), true
+	}
+	return d.locale.phrase(PhraseEveryNHours, p.step) + d.locale.partSeparator() +
+		d.locale.phrase(PhraseBetween, d.formatTime(p.start, minute), d.formatTime(p.end, minute)), true
+}
+
+// withSeconds prefixes a time description with the seconds field
+func (d *Descriptor) withSeconds(desc string) string {
+	seconds := d.locale.phrase(PhraseEverySecond)
+	if !d.expr.Second.IsAll() {
+		seconds = d.describeSeconds()
+	}
+	return seconds + d.locale.partSeparator() + desc
+}
 
-	// Regular days
-	days := d.expr.GetDaysOfMonth()
-	if len(days) == 0 {
+// describeDayOfMonth generates description for day-of-month field
+//
+// Plain days are described first, followed by each special value (L, L-N,
+// LW, NW) in ascending order of its encoding.
+func (d *Descriptor) describeDayOfMonth() string {
+	if d.expr.DayOfMonth.IsAll() {
 		return ""
 	}
 
-	if len(days) == 1 {
-		return fmt.Sprintf("on day %d of the month", days[0])
+	parts := make([]string, 0)
+	if days := d.expr.GetDaysOfMonth(); len(days) > 0 {
+		parts = append(parts, d.describeDaysOfMonth(days))
+	}
+
+	for _, v := range d.specialValues(d.expr.DayOfMonth) {
+		switch {
+		case v == 32:
+			parts = append(parts, d.locale.phrase(PhraseLastDayOfMonth))
+		case v == lastWeekdayValue:
+			parts = append(parts, d.locale.phrase(PhraseLastWeekdayOfMonth))
+		case v == 33:
+			parts = append(parts, d.locale.phrase(PhraseDayBeforeLastDay))
+		case v > 33 && v < lastWeekdayValue:
+			parts = append(parts, d.locale.phrase(PhraseDaysBeforeLastDay, v-32))
+		case v >= 101 && v <= 131:
+			parts = append(parts, d.locale.phrase(PhraseNearestWeekday, v-100))
+		}
+	}
+
+	return d.locale.list(parts)
+}
+
+// describeDaysOfMonth describes plain days of the month
+func (d *Descriptor) describeDaysOfMonth(days []int) string {
+	switch {
+	case len(days) == 1 && d.locale.DayOfMonth != nil:
+		return d.locale.phrase(PhraseDaysOfMonthList, d.locale.DayOfMonth(days[0]))
+	case len(days) == 1:
+		return d.locale.phrase(PhraseDayOfMonth, days[0])
+	}
+
+	// Check for step
+	if p, ok := stepOf(d.expr.DayOfMonth, days); ok && !d.opts.Verbose {
+		switch {
+		case p.full && p.start == 1:
+			return d.locale.phrase(PhraseEveryNDays, p.step)
+		case p.full:
+			return d.locale.phrase(PhraseEveryNDaysFrom, p.step, p.start)
+		}
+		return d.locale.phrase(PhraseEveryNDays, p.step) + d.locale.partSeparator() +
+			d.locale.phrase(PhraseDaysOfMonthRange, p.start, p.end)
 	}
 
 	// Check for range
-	if isConsecutive(days) {
-		return fmt.Sprintf("on days %d through %d of the month", days[0], days[len(days)-1])
+	if isConsecutive(days) && !d.opts.Verbose {
+		return d.locale.phrase(PhraseDaysOfMonthRange, days[0], days[len(days)-1])
 	}
 
-	return fmt.Sprintf("on day %s of the month", d.formatOrdinalList(days))
+	if d.locale.DayOfMonth != nil {
+		strs := make([]string, len(days))
+		for i, v := range days {
+			strs[i] = d.locale.DayOfMonth(v)
+		}
+		return d.locale.phrase(PhraseDaysOfMonthList, d.locale.list(strs))
+	}
+	return d.locale.phrase(PhraseDaysOfMonthList, d.formatOrdinalList(days))
 }
 
 // describeMonth generates description for month field
@@ -218,130 +451,216 @@ func (d *Descriptor) describeMonth() string {
 
 	monthNames := make([]string, len(months))
 	for i, m := range months {
-		monthNames[i] = monthToName(m)
+		monthNames[i] = d.locale.month(m)
 	}
 
 	if len(months) == 1 {
-		return fmt.Sprintf("in %s", monthNames[0])
+		return d.locale.phrase(PhraseInMonths, monthNames[0])
+	}
+
+	// Check for step
+	if p, ok := stepOf(d.expr.Month, months); ok && !d.opts.Verbose {
+		switch {
+		case p.full && p.start == 1:
+			return d.locale.phrase(PhraseEveryNMonths, p.step)
+		case p.full:
+			return d.locale.phrase(PhraseEveryNMonthsFrom, p.step, d.locale.month(p.start))
+		}
+		return d.locale.phrase(PhraseEveryNMonths, p.step) + d.locale.partSeparator() +
+			d.locale.phrase(PhraseMonthRange, d.locale.month(p.start), d.locale.month(p.end))
 	}
 
 	// Check for consecutive months
-	if isConsecutive(months) {
-		return fmt.Sprintf("from %s through %s", monthNames[0], monthNames[len(monthNames)-1])
+	if isConsecutive(months) && !d.opts.Verbose {
+		return d.locale.phrase(PhraseMonthRange, monthNames[0], monthNames[len(monthNames)-1])
 	}
 
-	return fmt.Sprintf("in %s", strings.Join(monthNames, ", "))
+	return d.locale.phrase(PhraseInMonths, d.locale.list(monthNames))
+}
+
+// isMonthStep reports whether the month field is described as a step
+func (d *Descriptor) isMonthStep() bool {
+	months := d.expr.GetMonths()
+	_, ok := stepOf(d.expr.Month, months)
+	return ok && len(months) > 1 && !d.opts.Verbose
 }
 
 // describeDayOfWeek generates description for day-of-week field
+//
+// Plain days are described first, followed by each NL and N#M value in
+// ascending order of its encoding.
 func (d *Descriptor) describeDayOfWeek() string {
 	if d.expr.DayOfWeek.IsAll() {
 		return ""
 	}
 
-	// Handle special Nth day of week
-	if d.expr.HasNthDayOfWeek {
-		for v := range d.expr.DayOfWeek.Values {
-			if v >= 21 && v <= 75 {
-				encoded := v - 20
-				weekday := encoded / 10
-				occurrence := encoded % 10
-				return fmt.Sprintf("on the %s %s of the month",
-					ordinal(occurrence), dayToName(weekday))
-			}
-		}
-	}
-
-	// Handle last day of week in month
-	if d.expr.HasLastDayOfWeek {
-		for v := range d.expr.DayOfWeek.Values {
-			if v >= 10 && v <= 16 {
-				weekday := v - 10
-				return fmt.Sprintf("on the last %s of the month", dayToName(weekday))
-			}
+	parts := make([]string, 0)
+	if days := d.expr.GetDaysOfWeek(); len(days) > 0 {
+		parts = append(parts, d.describeDaysOfWeek(days))
+	}
+
+	for _, v := range d.specialValues(d.expr.DayOfWeek) {
+		switch {
+		case v >= 10 && v <= 16:
+			parts = append(parts, d.locale.phrase(PhraseLastWeekdayOf, d.locale.weekday(v-10)))
+		case v >= 21 && v <= 75:
+			encoded := v - 20
+			weekday := encoded / 10
+			occurrence := encoded % 10
+			parts = append(parts, d.locale.phrase(PhraseNthWeekday,
+				d.locale.ordinal(occurrence), d.locale.weekday(weekday)))
 		}
 	}
 
-	// Regular days of week
-	days := d.expr.GetDaysOfWeek()
-	if len(days) == 0 {
-		return ""
-	}
+	return d.locale.list(parts)
+}
 
+// describeDaysOfWeek describes plain days of the week
+func (d *Descriptor) describeDaysOfWeek(days []int) string {
 	dayNames := make([]string, len(days))
 	for i, day := range days {
-		dayNames[i] = dayToName(day)
+		dayNames[i] = d.locale.weekday(day)
 	}
 
-	// Check for weekdays (Mon-Fri)
-	if len(days) == 5 && isConsecutive(days) && days[0] == 1 && days[4] == 5 {
-		return "on weekdays"
+	if len(days) == 1 {
+		return d.locale.phrase(PhraseOnDays, dayNames[0])
 	}
 
-	// Check for weekend
-	if len(days) == 2 && days[0] == 0 && days[1] == 6 {
-		return "on weekends"
-	}
+	if !d.opts.Verbose {
+		// Check for weekdays (Mon-Fri)
+		if len(days) == 5 && isConsecutive(days) && days[0] == 1 && days[4] == 5 {
+			return d.locale.phrase(PhraseOnWeekdays)
+		}
 
-	if len(days) == 1 {
-		return fmt.Sprintf("on %s", dayNames[0])
-	}
+		// Check for weekend
+		if len(days) == 2 && days[0] == 0 && days[1] == 6 {
+			return d.locale.phrase(PhraseOnWeekends)
+		}
 
-	// Check for consecutive days
-	if isConsecutive(days) {
-		return fmt.Sprintf("from %s through %s", dayNames[0], dayNames[len(dayNames)-1])
+		// Check for consecutive days
+		if isConsecutive(days) {
+			return d.locale.phrase(PhraseDayRange, dayNames[0], dayNames[len(dayNames)-1])
+		}
 	}
 
-	return fmt.Sprintf("on %s", strings.Join(dayNames, ", "))
+	return d.locale.phrase(PhraseOnDays, d.locale.list(dayNames))
 }
 
 // Helper methods
 
 func (d *Descriptor) formatTime(hour, minute int) string {
-	if d.opts.Use24HourTime {
-		return fmt.Sprintf("%02d:%02d", hour, minute)
-	}
+	return d.clock(hour, minute, 0, func(h, m, _ int) string {
+		return d.locale.formatTime(h, m, d.opts.Use24HourTime)
+	})
+}
+
+func (d *Descriptor) formatTimeSeconds(hour, minute, second int) string {
+	return d.clock(hour, minute, second, func(h, m, s int) string {
+		return d.locale.formatTimeSeconds(h, m, s, d.opts.Use24HourTime)
+	})
+}
 
-	period := "AM"
-	displayHour := hour
+func (d *Descriptor) formatHours(hours []int) string {
+	hourStrs := make([]string, len(hours))
+	for i, h := range hours {
+		hourStrs[i] = d.clock(h, 0, 0, func(h, m, _ int) string {
+			if m != 0 {
+				return d.locale.formatTime(h, m, d.opts.Use24HourTime)
+			}
+			return d.locale.formatHour(h, d.opts.Use24HourTime)
+		})
+	}
+	return d.locale.join(hourStrs)
+}
 
-	if hour == 0 {
-		displayHour = 12
-	} else if hour == 12 {
-		period = "PM"
-	} else if hour > 12 {
-		displayHour = hour - 12
-		period = "PM"
+// clock formats a time of day, followed by its equivalent in the viewer's
+// timezone if one is set
+func (d *Descriptor) clock(hour, minute, second int, format func(h, m, s int) string) string {
+	s := format(hour, minute, second)
+	if d.opts.ViewerLocation == nil {
+		return s
 	}
 
-	return fmt.Sprintf("%d:%02d %s", displayHour, minute, period)
+	local := d.reference()
+	t := time.Date(local.Year(), local.Month(), local.Day(), hour, minute, second, 0, local.Location())
+	v := t.In(d.opts.ViewerLocation)
+	converted := format(v.Hour(), v.Minute(), v.Second())
+
+	shift := civilDay(v) - civilDay(t)
+	switch {
+	case shift > 0:
+		return d.locale.phrase(PhraseViewerTimeNextDay, s, converted, d.zoneName(v))
+	case shift < 0:
+		return d.locale.phrase(PhraseViewerTimePreviousDay, s, converted, d.zoneName(v))
+	}
+	return d.locale.phrase(PhraseViewerTime, s, converted, d.zoneName(v))
 }
 
-func (d *Descriptor) formatHours(hours []int) string {
-	if len(hours) == 0 {
-		return ""
+// reference returns the reference time in the expression's timezone
+func (d *Descriptor) reference() time.Time {
+	ref := d.opts.ReferenceTime
+	if ref.IsZero() {
+		ref = time.Now()
+	}
+	loc := d.opts.Location
+	if loc == nil {
+		loc = time.UTC
 	}
+	return ref.In(loc)
+}
 
-	hourStrs := make([]string, len(hours))
-	for i, h := range hours {
-		if d.opts.Use24HourTime {
-			hourStrs[i] = fmt.Sprintf("%02d:00", h)
-		} else {
-			period := "AM"
-			displayHour := h
-			if h == 0 {
-				displayHour = 12
-			} else if h == 12 {
-				period = "PM"
-			} else if h > 12 {
-				displayHour = h - 12
-				period = "PM"
-			}
-			hourStrs[i] = fmt.Sprintf("%d %s", displayHour, period)
-		}
+// timezoneNote names the expression's timezone. Minutes past the hour, as in
+// "every 2 hours", cannot be converted for a viewer whose timezone is offset
+// by a fraction of an hour, so the note then gives the offset instead.
+func (d *Descriptor) timezoneNote() string {
+	ref := d.reference()
+	zone := d.zoneName(ref)
+	if d.opts.ViewerLocation == nil || !d.minutesPastHour() {
+		return zone
+	}
+
+	v := ref.In(d.opts.ViewerLocation)
+	_, offset := ref.Zone()
+	_, viewerOffset := v.Zone()
+	diff := time.Duration(viewerOffset-offset) * time.Second
+	switch {
+	case diff%time.Hour == 0:
+		return zone
+	case diff > 0:
+		return d.locale.phrase(PhraseViewerAhead, zone, d.zoneName(v), d.locale.duration(diff))
+	}
+	return d.locale.phrase(PhraseViewerBehind, zone, d.zoneName(v), d.locale.duration(diff))
+}
+
+// minutesPastHour reports whether the time description gives minutes past
+// the hour rather than only clock times, as in "at 5 minutes past every hour"
+// or "every 15 minutes"
+func (d *Descriptor) minutesPastHour() bool {
+	minutes := d.expr.GetMinutes()
+	switch {
+	case d.expr.Minute.IsAll():
+		return false
+	case len(minutes) > 1 || d.expr.Hour.IsAll():
+		return true
+	case d.opts.Verbose:
+		return false
 	}
+	// "every 2 hours", or "at minute 0, every hour between ..."
+	hours := d.expr.GetHours()
+	p, ok := stepOf(d.expr.Hour, hours)
+	if !ok {
+		return len(hours) > 2 && len(hours) < 24 && isConsecutive(hours)
+	}
+	return p.full && p.start == 0 && minutes[0] == 0
+}
 
-	return strings.Join(hourStrs, ", ")
+// zoneName names the timezone of t by location or abbreviation
+func (d *Descriptor) zoneName(t time.Time) string {
+	if d.opts.TimezoneAbbreviation {
+		name, _ := t.Zone()
+		return name
+	}
+	return t.Location().String()
 }
 
 func (d *Descriptor) formatList(values []int) string {
@@ -349,40 +668,17 @@ func (d *Descriptor) formatList(values []int) string {
 		return ""
 	}
 
-	// Check for step pattern
-	if len(values) > 2 {
-		step := values[1] - values[0]
-		isStep := step > 0
-		for i := 2; i < len(values) && isStep; i++ {
-			if values[i]-values[i-1] != step {
-				isStep = false
-			}
-		}
-		if isStep && step > 1 {
-			return fmt.Sprintf("every %d starting at %d", step, values[0])
-		}
-	}
-
 	// Check for range
-	if isConsecutive(values) && len(values) > 2 {
-		return fmt.Sprintf("%d through %d", values[0], values[len(values)-1])
+	if isConsecutive(values) && len(values) > 2 && !d.opts.Verbose {
+		return d.locale.phrase(PhraseValueRange, values[0], values[len(values)-1])
 	}
 
 	// List individual values
 	strs := make([]string, len(values))
 	for i, v := range values {
-		strs[i] = fmt.Sprintf("%d", v)
-	}
-
-	if len(strs) == 2 {
-		return strs[0] + " and " + strs[1]
-	}
-
-	if len(strs) > 2 {
-		return strings.Join(strs[:len(strs)-1], ", ") + ", and " + strs[len(strs)-1]
+		strs[i] = strconv.Itoa(v)
 	}
-
-	return strs[0]
+	return d.locale.list(strs)
 }
 
 func (d *Descriptor) formatOrdinalList(values []int) string {
@@ -392,27 +688,74 @@ func (d *Descriptor) formatOrdinalList(values []int) string {
 
 	strs := make([]string, len(values))
 	for i, v := range values {
-		strs[i] = ordinal(v)
+		strs[i] = d.locale.ordinal(v)
+	}
+	return d.locale.list(strs)
+}
+
+// specialValues returns the special values of a day field in ascending order
+func (d *Descriptor) specialValues(f *Field) []int {
+	bounds := fieldBounds[f.Type]
+	values := make([]int, 0)
+	for v := range f.Values {
+		if v < bounds.min || v > bounds.max {
+			values = append(values, v)
+		}
 	}
+	sort.Ints(values)
+	return values
+}
+
+// stepPattern is a field's values as written with a step, e.g. "*/15" or
+// "9-17/2"
+type stepPattern struct {
+	start, end, step int
 
-	if len(strs) == 2 {
-		return strs[0] + " and " + strs[1]
+	// full is set when the values run from the start to the end of the
+	// field, so only the step and offset need describing
+	full bool
+}
+
+// stepOf reconstructs the step a field was written with from its values.
+// Only fields whose raw text contains a step are considered, so lists such
+// as "1,15" keep their list description, except that seconds and minutes
+// listed three or more at even spacing, such as "0,15,30,45", read as the
+// step they spell out.
+func stepOf(f *Field, values []int) (stepPattern, bool) {
+	spelled := (f.Type == FieldSecond || f.Type == FieldMinute) && len(values) > 2
+	if !strings.Contains(f.Raw, "/") && !spelled || len(values) < 2 {
+		return stepPattern{}, false
 	}
 
-	if len(strs) > 2 {
-		return strings.Join(strs[:len(strs)-1], ", ") + ", and " + strs[len(strs)-1]
+	step := values[1] - values[0]
+	if step < 2 {
+		return stepPattern{}, false
+	}
+	for i := 2; i < len(values); i++ {
+		if values[i]-values[i-1] != step {
+			return stepPattern{}, false
+		}
 	}
 
-	return strs[0]
+	bounds := fieldBounds[f.Type]
+	p := stepPattern{start: values[0], end: values[len(values)-1], step: step}
+	p.full = p.start-bounds.min < step && bounds.max-p.end < step
+	return p, true
 }
 
 // Utility functions
 
 func capitalizeFirst(s string) string {
-	if len(s) == 0 {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
 		return s
 	}
-	return strings.ToUpper(string(s[0])) + s[1:]
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// civilDay numbers the calendar day of t in its own timezone
+func civilDay(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400)
 }
 
 func isConsecutive(values []int) bool {
@@ -427,45 +770,8 @@ func isConsecutive(values []int) bool {
 	return true
 }
 
-func ordinal(n int) string {
-	suffix := "th"
-	switch n % 10 {
-	case 1:
-		if n%100 != 11 {
-			suffix = "st"
-		}
-	case 2:
-		if n%100 != 12 {
-			suffix = "nd"
-		}
-	case 3:
-		if n%100 != 13 {
-			suffix = "rd"
-		}
-	}
-	return fmt.Sprintf("%d%s", n, suffix)
-}
-
 func monthToName(month int) string {
-	names := []string{
-		"", "January", "February", "March", "April", "May", "June",
-		"July", "August", "September", "October", "November", "December",
-	}
-	if month >= 1 && month <= 12 {
-		return names[month]
-	}
-	return fmt.Sprintf("Month %d", month)
-}
-
-func dayToName(day int) string {
-	names := []string{
-		"Sunday", "Monday", "Tuesday", "Wednesday",
-		"Thursday", "Friday", "Saturday",
-	}
-	if day >= 0 && day <= 6 {
-		return names[day]
-	}
-	return fmt.Sprintf("Day %d", day)
+	return localeEN.month(month)
 }
 
 // Describe is a convenience function to describe an expression
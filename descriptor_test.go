@@ -1,6 +1,9 @@
 package expressparser
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestDescribe_EveryMinute(t *testing.T) {
 	expr, err := Parse("* * * * *")
@@ -58,3 +61,272 @@ func TestDescribe_MonthAndDayOfMonth(t *testing.T) {
 		t.Errorf("Describe() returned empty string, want non-empty description")
 	}
 }
+
+func TestDescribe_SpecialDays(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"0 0 L,15 * *", "At 12:00 AM, on day 15 of the month and on the last day of the month"},
+		{"0 0 * * 1#1,5L", "At 12:00 AM, on the last Friday of the month and on the 1st Monday of the month"},
+		{"0 9 * * 1,5L", "At 9:00 AM, on Monday and on the last Friday of the month"},
+		{"0 0 L-3,10W * *", "At 12:00 AM, on the day 3 days before the last day of the month and on the weekday nearest to day 10 of the month"},
+		{"0 0 LW,L * *", "At 12:00 AM, on the last day of the month and on the last weekday of the month"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr := MustParse(tt.expr)
+			// Special values are stored in maps, so repeat to catch
+			// iteration-order dependent output
+			for i := 0; i < 20; i++ {
+				if got := Describe(expr); got != tt.want {
+					t.Fatalf("Describe() = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDescribe_DayOfMonthOrDayOfWeek(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"0 0 1 * 1", "At 12:00 AM, on day 1 of the month or on Monday"},
+		{"0 0 L * 1-5", "At 12:00 AM, on the last day of the month or on weekdays"},
+		{"0 0 1,15 6 1#2", "At 12:00 AM, on day 1st and 15th of the month or on the 2nd Monday of the month, in June"},
+		{"0 0 ? * 1", "At 12:00 AM, on Monday"},
+	}
+
+	for _, tt := range tests {
+		if got := Describe(MustParse(tt.expr)); got != tt.want {
+			t.Errorf("Describe(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeWithOptions_Verbose(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"0 0 9 * * *", "At 9:00:00 AM"},
+		{"30 0 9,17 * * *", "At 9:00:30 AM, 5:00:30 PM"},
+		{"* 0 9 * * *", "Every second, at 9:00 AM"},
+		{"0,30 5 * * * *", "At second 0 and 30, at 5 minute(s) past every hour"},
+		{"0 9 * * *", "At 9:00 AM"},
+		{"*/15 9-11 * * *", "At minute 0, 15, 30, and 45, during hour 9, 10, and 11"},
+		{"0 0 1-3 1-3 1-5", "At 12:00 AM, on day 1st, 2nd, and 3rd of the month or on Monday, Tuesday, Wednesday, Thursday, and Friday, in January, February, and March"},
+	}
+
+	opts := DescriptionOptions{Verbose: true}
+	for _, tt := range tests {
+		if got := DescribeWithOptions(MustParse(tt.expr), opts); got != tt.want {
+			t.Errorf("DescribeWithOptions(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestDescribe_Steps(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		// Seconds
+		{"*/10 * * * * *", "Every 10 seconds"},
+		{"5/20 * * * * *", "Every 20 seconds starting at second 5"},
+		{"0-30/10 * * * * *", "Every 10 seconds, from second 0 through 30"},
+		{"* * 9 * * *", "Every second, between 9:00 AM and 9:59 AM"},
+
+		// Minutes
+		{"*/15 * * * *", "Every 15 minutes"},
+		{"*/15 9-17 * * *", "Every 15 minutes, between 9:00 AM and 5:59 PM"},
+		{"5/15 * * * *", "Every 15 minutes starting at minute 5"},
+		{"0-30/10 * * * *", "Every 10 minutes, from minute 0 through 30 past the hour"},
+		{"* 9-17 * * *", "Every minute, between 9:00 AM and 5:59 PM"},
+		{"0 */5 * * * *", "Every 5 minutes"},
+		{"30 */5 * * * *", "At second 30, every 5 minutes"},
+		{"30 */5 9-17 * * *", "At second 30, every 5 minutes, between 9:00 AM and 5:59 PM"},
+		{"15,45 */10 * * * *", "At second 15 and 45, every 10 minutes"},
+		{"*/10 */5 * * * *", "Every 10 seconds, every 5 minutes"},
+		{"30 0 */2 * * *", "At second 30, every 2 hours"},
+		{"30 0 1/2 * * *", "At second 30, every 2 hours starting at 1:00 AM"},
+		{"0,15,30,45 9,10,11,12 * * *", "Every 15 minutes, between 9:00 AM and 12:59 PM"},
+		{"0,30 */2 * * *", "At minute 0 and 30, every 2 hours"},
+		{"15,45 1/2 * * *", "At minute 15 and 45, every 2 hours starting at 1:00 AM"},
+
+		// Hours
+		{"0 */2 * * *", "Every 2 hours"},
+		{"0 1/2 * * *", "Every 2 hours starting at 1:00 AM"},
+		{"30 */3 * * *", "Every 3 hours starting at 12:30 AM"},
+		{"0 9-17/2 * * *", "Every 2 hours, between 9:00 AM and 5:00 PM"},
+		{"0 9-17 * * *", "At minute 0, every hour between 9:00 AM and 5:00 PM"},
+		{"30 9-17 * * 1-5", "At minute 30, every hour between 9:30 AM and 5:30 PM, on weekdays"},
+		{"0 9-10 * * *", "At 9:00 AM, 10:00 AM"},
+
+		// Days of the month
+		{"0 0 */2 * *", "At 12:00 AM, every 2 days"},
+		{"0 0 3/5 * *", "At 12:00 AM, every 5 days starting on day 3 of the month"},
+		{"0 0 1-15/2 * *", "At 12:00 AM, every 2 days, on days 1 through 15 of the month"},
+
+		// Months
+		{"0 0 1 */3 *", "At 12:00 AM, on day 1 of the month, every 3 months"},
+		{"0 0 * */3 *", "At 12:00 AM, every day, every 3 months"},
+		{"0 0 1 2/3 *", "At 12:00 AM, on day 1 of the month, every 3 months starting in February"},
+		{"0 0 1 3-9/2 *", "At 12:00 AM, on day 1 of the month, every 2 months, from March through September"},
+
+		// Days of the week are clearer by name
+		{"0 0 * * */2", "At 12:00 AM, on Sunday, Tuesday, Thursday, and Saturday"},
+
+		// Pairs and day lists that happen to be evenly spaced are not steps
+		{"0,30 * * * *", "At minute 0 and 30 of every hour"},
+		{"0 0 1,15 * *", "At 12:00 AM, on day 1st and 15th of the month"},
+	}
+
+	for _, tt := range tests {
+		if got := Describe(MustParse(tt.expr)); got != tt.want {
+			t.Errorf("Describe(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestDescribe_SecondsOfTimes(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"30 5 * * * *", "At second 30, at 5 minute(s) past every hour"},
+		{"30 0,30 * * * *", "At second 30, at minute 0 and 30 of every hour"},
+		{"0,30 0 * * * *", "At second 0 and 30, at minute 0 of every hour"},
+		{"30 0 9,17 * * *", "At 9:00:30 AM, 5:00:30 PM"},
+		{"15,45 0 9 * * *", "At second 15 and 45, at 9:00 AM"},
+		{"*/10 0 */2 * * *", "Every 10 seconds, at minute 0, every 2 hours"},
+	}
+
+	for _, tt := range tests {
+		if got := Describe(MustParse(tt.expr)); got != tt.want {
+			t.Errorf("Describe(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeWithOptions_StepsVerbose(t *testing.T) {
+	// Verbose spells the values out instead
+	got := DescribeWithOptions(MustParse("*/20 9-10 * * *"), DescriptionOptions{Verbose: true})
+	want := "At minute 0, 20, and 40 of 9 AM, 10 AM"
+	if got != want {
+		t.Errorf("DescribeWithOptions() = %q, want %q", got, want)
+	}
+}
+
+func TestSchedule_DescribeTimezone(t *testing.T) {
+	schedule, err := NewScheduleInTimezone("0 9 * * 1-5", "America/New_York")
+	if err != nil {
+		t.Fatalf("NewScheduleInTimezone error: %v", err)
+	}
+
+	if got, want := schedule.Describe(), "At 9:00 AM, on weekdays (America/New_York)"; got != want {
+		t.Errorf("Describe() = %q, want %q", got, want)
+	}
+
+	// Abbreviations follow daylight saving time at the reference time
+	winter := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+	summer := time.Date(2024, time.July, 15, 0, 0, 0, 0, time.UTC)
+	for ref, want := range map[time.Time]string{
+		winter: "At 9:00 AM, on weekdays (EST)",
+		summer: "At 9:00 AM, on weekdays (EDT)",
+	} {
+		got := schedule.DescribeWithOptions(DescriptionOptions{TimezoneAbbreviation: true, ReferenceTime: ref})
+		if got != want {
+			t.Errorf("DescribeWithOptions(%s) = %q, want %q", ref.Month(), got, want)
+		}
+	}
+}
+
+func TestDescribeWithOptions_ViewerLocation(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+	winter := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		opts DescriptionOptions
+		want string
+	}{
+		{
+			name: "next day",
+			expr: "0 21 * * 1-5",
+			opts: DescriptionOptions{Location: newYork, ViewerLocation: tokyo, ReferenceTime: winter},
+			want: "At 9:00 PM (11:00 AM Asia/Tokyo, next day), on weekdays (America/New_York)",
+		},
+		{
+			name: "previous day",
+			expr: "0 8 * * *",
+			opts: DescriptionOptions{Location: tokyo, ViewerLocation: newYork, ReferenceTime: winter, Use24HourTime: true},
+			want: "At 08:00 (18:00 America/New_York, previous day) (Asia/Tokyo)",
+		},
+		{
+			name: "several times with abbreviations",
+			expr: "0 9,17 * * *",
+			opts: DescriptionOptions{Location: newYork, ViewerLocation: berlin, ReferenceTime: winter, TimezoneAbbreviation: true},
+			want: "At 9:00 AM (3:00 PM CET), 5:00 PM (11:00 PM CET) (EST)",
+		},
+		{
+			// America switches to daylight saving time three weeks before Europe
+			name: "between daylight saving changes",
+			expr: "0 9 * * *",
+			opts: DescriptionOptions{Location: newYork, ViewerLocation: berlin,
+				ReferenceTime: time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC), TimezoneAbbreviation: true},
+			want: "At 9:00 AM (2:00 PM CET) (EDT)",
+		},
+		{
+			name: "steps",
+			expr: "*/15 9-17 * * *",
+			opts: DescriptionOptions{ViewerLocation: tokyo, ReferenceTime: winter, Use24HourTime: true},
+			want: "Every 15 minutes, between 09:00 (18:00 Asia/Tokyo) and 17:59 (02:59 Asia/Tokyo, next day) (UTC)",
+		},
+		{
+			name: "minutes past the hour across a half-hour offset",
+			expr: "0 */2 * * *",
+			opts: DescriptionOptions{ViewerLocation: kolkata, ReferenceTime: winter},
+			want: "Every 2 hours (UTC; Asia/Kolkata is 5 hours 30 minutes ahead)",
+		},
+		{
+			name: "minute lists across a half-hour offset",
+			expr: "0,30 9,17 * * *",
+			opts: DescriptionOptions{Location: kolkata, ViewerLocation: newYork, ReferenceTime: winter, Use24HourTime: true},
+			want: "At minute 0 and 30 of 09:00 (22:30 America/New_York, previous day), 17:00 (06:30 America/New_York) " +
+				"(Asia/Kolkata; America/New_York is 10 hours 30 minutes behind)",
+		},
+		{
+			name: "minutes past the hour across a whole-hour offset",
+			expr: "5 * * * *",
+			opts: DescriptionOptions{ViewerLocation: tokyo, ReferenceTime: winter},
+			want: "At 5 minute(s) past every hour (UTC)",
+		},
+		{
+			name: "clock times across a half-hour offset",
+			expr: "0 9 * * *",
+			opts: DescriptionOptions{ViewerLocation: kolkata, ReferenceTime: winter},
+			want: "At 9:00 AM (2:30 PM Asia/Kolkata) (UTC)",
+		},
+		{
+			name: "locale",
+			expr: "0 21 * * *",
+			opts: DescriptionOptions{Location: newYork, ViewerLocation: tokyo, ReferenceTime: winter, Locale: "de"},
+			want: "Um 21:00 (11:00 Asia/Tokyo, am nächsten Tag) (America/New_York)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DescribeWithOptions(MustParse(tt.expr), tt.opts); got != tt.want {
+				t.Errorf("DescribeWithOptions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
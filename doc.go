@@ -129,6 +129,36 @@
 //	desc = expressparser.DescribeWithOptions(expr, opts)
 //	// Output: "At 09:00, on weekdays"
 //
+//	// In another language; en, de, fr, es and ja are built in and
+//	// RegisterLocale adds more
+//	opts = expressparser.DescriptionOptions{Locale: "de"}
+//	desc = expressparser.DescribeWithOptions(expr, opts)
+//	// Output: "Um 09:00, an Werktagen"
+//
+// Every value of the day fields is described, including mixed lists such as
+// "L,15", and an expression restricting both days of the month and days of
+// the week reads "on day 1 of the month or on Monday". Verbose spells out
+// each value instead of ranges and steps, and the seconds of six-field
+// expressions:
+//
+//	opts = expressparser.DescriptionOptions{Verbose: true}
+//	desc = expressparser.DescribeWithOptions(expressparser.MustParse("0 0 9 * * 1-3"), opts)
+//	// Output: "At 9:00:00 AM, on Monday, Tuesday, and Wednesday"
+//
+// ParseNatural is the inverse of Describe for English text:
+//
+//	expr, err := expressparser.ParseNatural("first Monday of each month at noon")
+//	// expr.String(): "0 12 * * 1#1"
+//
+// Expression.Explain returns a structured breakdown of each field, with its
+// values, L, W and # rules and description, that marshals to a versioned JSON
+// schema for UIs.
+//
+// Builder constructs expressions fluently, including L, W and # rules:
+//
+//	expr, err := expressparser.NewBuilder().AtMinute(0).AtHour(9).OnNth(2, time.Monday).Build()
+//	// expr.String(): "0 9 * * 1#2"
+//
 // # Error Handling
 //
 // The package provides detailed error types for better error handling:
@@ -163,6 +193,20 @@
 //	fmt.Println(schedule.Timezone())              // Timezone location
 //	fmt.Println(schedule.IsDue(time.Now()))       // Check if due now
 //
+// DescribeNext and DescribeLast describe runs relative to a time, honouring
+// the Locale and Use24HourTime options:
+//
+//	desc, _ := schedule.DescribeNext(time.Now())
+//	// Output: "next run in 3 hours 12 minutes (Tue 9:00 AM)"
+//
+// Expression and Schedule implement encoding.TextMarshaler,
+// encoding.TextUnmarshaler, json.Marshaler and json.Unmarshaler, so config
+// struct fields are validated while decoding. A Schedule encodes as
+// {"expression": "0 9 * * 1-5", "timezone": "America/New_York"} in JSON and as
+// "CRON_TZ=America/New_York 0 9 * * 1-5" in text.
+// Expression also implements sql.Scanner and driver.Valuer, and
+// NullExpression handles nullable columns.
+//
 // # Thread Safety
 //
 // All types in this package are safe for concurrent use. The Expression and
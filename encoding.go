@@ -0,0 +1,166 @@
+// encoding.go - Text and JSON encoding of expressions and schedules
+
+package expressparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timezonePrefixes introduce the timezone of a schedule's text form, as in
+// "CRON_TZ=America/New_York 0 9 * * 1-5"
+var timezonePrefixes = []string{"CRON_TZ=", "TZ="}
+
+// MarshalText implements encoding.TextMarshaler, encoding the expression as
+// its cron string, or empty text for the zero Expression
+func (e Expression) MarshalText() ([]byte, error) {
+	if e.Minute == nil {
+		return []byte{}, nil
+	}
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing text as a cron
+// expression. Empty text sets the zero Expression.
+//
+// Invalid text fails with the error Parse returns, so errors.As finds the
+// *ParseError, *FieldError, *RangeError or *StepError describing it.
+func (e *Expression) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*e = Expression{}
+		return nil
+	}
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*e = *parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the expression as a JSON
+// string, or null for the zero Expression
+func (e Expression) MarshalJSON() ([]byte, error) {
+	if e.Minute == nil {
+		return []byte("null"), nil
+	}
+	text, err := e.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing a JSON string as a cron
+// expression. JSON null leaves the expression unchanged.
+func (e *Expression) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("cron expression must be a JSON string: %w", err)
+	}
+	return e.UnmarshalText([]byte(text))
+}
+
+// scheduleJSON is the JSON object form of a Schedule
+type scheduleJSON struct {
+	Expression string `json:"expression"`
+	Timezone   string `json:"timezone,omitempty"`
+}
+
+// MarshalText implements encoding.TextMarshaler. Schedules outside UTC are
+// prefixed with their timezone: "CRON_TZ=America/New_York 0 9 * * 1-5"
+func (s Schedule) MarshalText() ([]byte, error) {
+	if s.expression == nil {
+		return []byte{}, nil
+	}
+	text := s.expression.String()
+	if loc := s.Timezone(); loc != nil && loc != time.UTC {
+		text = timezonePrefixes[0] + loc.String() + " " + text
+	}
+	return []byte(text), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting a cron
+// expression optionally prefixed with "CRON_TZ=<zone> " or "TZ=<zone> ".
+// Empty text sets the zero Schedule.
+//
+// Invalid expressions fail as for Expression.UnmarshalText and unknown
+// timezones with ErrInvalidTimezone.
+func (s *Schedule) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*s = Schedule{}
+		return nil
+	}
+	expr, tz := strings.TrimSpace(string(text)), ""
+	for _, prefix := range timezonePrefixes {
+		if rest, ok := strings.CutPrefix(expr, prefix); ok {
+			tz, expr, _ = strings.Cut(rest, " ")
+			break
+		}
+	}
+	return s.set(expr, tz)
+}
+
+// MarshalJSON implements json.Marshaler, encoding the schedule as an object:
+// {"expression": "0 9 * * 1-5", "timezone": "America/New_York"}
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	if s.expression == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(scheduleJSON{
+		Expression: s.expression.String(),
+		Timezone:   s.Timezone().String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the object form
+// written by MarshalJSON or a string in the text form. A missing timezone
+// means UTC and JSON null leaves the schedule unchanged.
+func (s *Schedule) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return s.UnmarshalText([]byte(text))
+	}
+
+	var v scheduleJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("schedule must be a JSON string or object: %w", err)
+	}
+	return s.set(v.Expression, v.Timezone)
+}
+
+// set replaces the schedule with expr in timezone tz, UTC if tz is empty
+func (s *Schedule) set(expr, tz string) error {
+	e, err := Parse(expr)
+	if err != nil {
+		return err
+	}
+
+	loc := time.UTC
+	if tz != "" {
+		if loc, err = time.LoadLocation(tz); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidTimezone, tz)
+		}
+	}
+
+	s.expression = e
+	s.scheduler = NewScheduler(e, WithLocation(loc))
+	return nil
+}
+
+// isJSONNull reports whether data is the JSON literal null
+func isJSONNull(data []byte) bool {
+	return string(bytes.TrimSpace(data)) == "null"
+}
@@ -0,0 +1,201 @@
+// encoding_test.go - Tests for text and JSON encoding
+
+package expressparser
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExpression_TextRoundTrip(t *testing.T) {
+	for _, input := range []string{"*/15 9-17 * * 1-5", "30 0 9 L * ?", "0 0 * * 5L", "@daily"} {
+		var e Expression
+		if err := e.UnmarshalText([]byte(input)); err != nil {
+			t.Fatalf("UnmarshalText(%q) error: %v", input, err)
+		}
+		text, err := e.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText error: %v", err)
+		}
+		if want := MustParse(input).String(); string(text) != want {
+			t.Errorf("MarshalText() = %q, want %q", text, want)
+		}
+	}
+}
+
+func TestExpression_JSON(t *testing.T) {
+	type job struct {
+		Name     string      `json:"name"`
+		Schedule Expression  `json:"schedule"`
+		Backup   *Expression `json:"backup,omitempty"`
+	}
+
+	var j job
+	if err := json.Unmarshal([]byte(`{"name":"report","schedule":"0 9 * * MON-FRI","backup":"@hourly"}`), &j); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if !j.Schedule.DayOfWeek.Contains(1) || j.Schedule.DayOfWeek.Contains(0) {
+		t.Errorf("Schedule = %q, want weekdays", j.Schedule.String())
+	}
+	if j.Backup == nil || j.Backup.String() != "0 * * * *" {
+		t.Errorf("Backup = %v, want 0 * * * *", j.Backup)
+	}
+
+	data, err := json.Marshal(j)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if want := `{"name":"report","schedule":"0 9 * * MON-FRI","backup":"0 * * * *"}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestExpression_JSONErrors(t *testing.T) {
+	var v struct {
+		Schedule Expression `json:"schedule"`
+	}
+
+	err := json.Unmarshal([]byte(`{"schedule":"61 * * * *"}`), &v)
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != FieldMinute || fieldErr.Value != "61" {
+		t.Errorf("error = %v, want minute *FieldError for 61", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"schedule":"@dialy"}`), &v)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Suggestion != "@daily" {
+		t.Errorf("error = %v, want *ParseError suggesting @daily", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"schedule":5}`), &v); err == nil {
+		t.Error("expected error for a JSON number")
+	}
+
+	if err := json.Unmarshal([]byte(`{"schedule":null}`), &v); err != nil {
+		t.Errorf("null error: %v", err)
+	}
+}
+
+func TestSchedule_Text(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		zone  string
+	}{
+		{"0 9 * * 1-5", "0 9 * * 1-5", "UTC"},
+		{"CRON_TZ=America/New_York 0 9 * * 1-5", "CRON_TZ=America/New_York 0 9 * * 1-5", "America/New_York"},
+		{"TZ=Asia/Tokyo  30 0 9 * * *", "CRON_TZ=Asia/Tokyo 30 0 9 * * *", "Asia/Tokyo"},
+		{"CRON_TZ=UTC @hourly", "0 * * * *", "UTC"},
+	}
+
+	for _, tt := range tests {
+		var s Schedule
+		if err := s.UnmarshalText([]byte(tt.input)); err != nil {
+			t.Fatalf("UnmarshalText(%q) error: %v", tt.input, err)
+		}
+		if got := s.Timezone().String(); got != tt.zone {
+			t.Errorf("UnmarshalText(%q) timezone = %s, want %s", tt.input, got, tt.zone)
+		}
+		text, err := s.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText error: %v", err)
+		}
+		if string(text) != tt.want {
+			t.Errorf("MarshalText() = %q, want %q", text, tt.want)
+		}
+	}
+
+	var s Schedule
+	if err := s.UnmarshalText([]byte("CRON_TZ=Mars/Olympus 0 9 * * *")); !errors.Is(err, ErrInvalidTimezone) {
+		t.Errorf("error = %v, want ErrInvalidTimezone", err)
+	}
+}
+
+func TestSchedule_JSON(t *testing.T) {
+	var v struct {
+		A Schedule  `json:"a"`
+		B *Schedule `json:"b"`
+	}
+	input := `{"a":{"expression":"0 9 * * 1-5","timezone":"Europe/London"},"b":"CRON_TZ=Asia/Tokyo 0 0 L * *"}`
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if v.A.Timezone().String() != "Europe/London" || v.A.String() != "0 9 * * 1-5" {
+		t.Errorf("A = %s in %s", v.A.String(), v.A.Timezone())
+	}
+	if v.B.Timezone().String() != "Asia/Tokyo" || !v.B.Expression().HasLastDayOfMonth {
+		t.Errorf("B = %s in %s", v.B.String(), v.B.Timezone())
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	want := `{"a":{"expression":"0 9 * * 1-5","timezone":"Europe/London"},"b":{"expression":"0 0 L * *","timezone":"Asia/Tokyo"}}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var s Schedule
+	if err := json.Unmarshal([]byte(`{"expression":"0 9 * * *"}`), &s); err != nil || s.Timezone().String() != "UTC" {
+		t.Errorf("missing timezone: %v, %v", s.Timezone(), err)
+	}
+
+	err = json.Unmarshal([]byte(`{"expression":"0 25 * * *","timezone":"UTC"}`), &s)
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != FieldHour {
+		t.Errorf("error = %v, want hour *FieldError", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"expression":"0 9 * * *","timezone":"Nowhere/City"}`), &s)
+	if !errors.Is(err, ErrInvalidTimezone) || !strings.Contains(err.Error(), "Nowhere/City") {
+		t.Errorf("error = %v, want ErrInvalidTimezone naming the zone", err)
+	}
+}
+
+func TestEncoding_ZeroValues(t *testing.T) {
+	type config struct {
+		Expression Expression `json:"expression"`
+		Schedule   Schedule   `json:"schedule"`
+	}
+
+	data, err := json.Marshal(config{})
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if want := `{"expression":null,"schedule":null}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	var c config
+	if err := json.Unmarshal(data, &c); err != nil {
+		t.Fatalf("Unmarshal(%s) error: %v", data, err)
+	}
+	if c.Expression.Minute != nil || c.Schedule.Expression() != nil {
+		t.Errorf("Unmarshal(%s) = %+v, want zero values", data, c)
+	}
+
+	// Text round-trips through empty text, which resets a set value
+	e, s := *MustParse("0 9 * * *"), Schedule{}
+	if err := s.UnmarshalText([]byte("0 9 * * *")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if text, err := (Expression{}).MarshalText(); err != nil || len(text) != 0 {
+		t.Errorf("Expression.MarshalText() = %q, %v, want empty", text, err)
+	}
+	if text, err := (Schedule{}).MarshalText(); err != nil || len(text) != 0 {
+		t.Errorf("Schedule.MarshalText() = %q, %v, want empty", text, err)
+	}
+	if err := e.UnmarshalText(nil); err != nil || e.Minute != nil {
+		t.Errorf("Expression.UnmarshalText(empty) = %v, want the zero value", err)
+	}
+	if err := s.UnmarshalText(nil); err != nil || s.Expression() != nil {
+		t.Errorf("Schedule.UnmarshalText(empty) = %v, want the zero value", err)
+	}
+
+	// A database column must hold an expression
+	if err := e.Scan(""); !errors.Is(err, ErrEmptyExpression) {
+		t.Errorf("Scan(empty) = %v, want ErrEmptyExpression", err)
+	}
+}
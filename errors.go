@@ -5,6 +5,7 @@ package expressparser
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // Sentinel errors for common error cases
@@ -31,14 +32,29 @@ type ParseError struct {
 	Field      string // The field that caused the error (minute, hour, etc.)
 	Value      string // The value that caused the error
 	Reason     string // Human-readable reason for the error
+	Offset     int    // Byte offset of Value in the parsed string
+	Length     int    // Byte length of Value in the parsed string, 0 if unknown
+	Suggestion string // Likely intended value, if one could be guessed
 }
 
 // Error implements the error interface
 func (e *ParseError) Error() string {
+	var msg string
 	if e.Field != "" {
-		return fmt.Sprintf("parse error in %s field: %q - %s", e.Field, e.Value, e.Reason)
+		msg = fmt.Sprintf("parse error in %s field: %q - %s", e.Field, e.Value, e.Reason)
+	} else {
+		msg = fmt.Sprintf("parse error: %q - %s", e.Expression, e.Reason)
 	}
-	return fmt.Sprintf("parse error: %q - %s", e.Expression, e.Reason)
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf(" (did you mean %q?)", e.Suggestion)
+	}
+	return msg
+}
+
+// Span returns the byte range [start, end) of the offending value in the
+// parsed string. ok is false if the position is unknown.
+func (e *ParseError) Span() (start, end int, ok bool) {
+	return e.Offset, e.Offset + e.Length, e.Length > 0
 }
 
 // NewParseError creates a new ParseError
@@ -52,18 +68,35 @@ func NewParseError(expression, field, value, reason string) *ParseError {
 }
 
 // FieldError represents an error in a specific cron field
+//
+// Offset and Length locate Value in the string given to the parser that
+// returned the error: the whole expression for Parse, or the field for
+// FieldParser.Parse.
 type FieldError struct {
-	Field  FieldType // The type of field (Minute, Hour, etc.)
-	Value  string    // The problematic value
-	Min    int       // Minimum allowed value
-	Max    int       // Maximum allowed value
-	Reason string    // Reason for the error
+	Field      FieldType // The type of field (Minute, Hour, etc.)
+	Value      string    // The problematic value
+	Min        int       // Minimum allowed value
+	Max        int       // Maximum allowed value
+	Reason     string    // Reason for the error
+	Offset     int       // Byte offset of Value in the parsed string
+	Length     int       // Byte length of Value in the parsed string, 0 if unknown
+	Suggestion string    // Likely intended value, e.g. "MON" for "MONN"
 }
 
 // Error implements the error interface
 func (e *FieldError) Error() string {
-	return fmt.Sprintf("invalid %s field %q: %s (allowed range: %d-%d)",
+	msg := fmt.Sprintf("invalid %s field %q: %s (allowed range: %d-%d)",
 		e.Field, e.Value, e.Reason, e.Min, e.Max)
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf("; did you mean %q?", e.Suggestion)
+	}
+	return msg
+}
+
+// Span returns the byte range [start, end) of the offending value in the
+// parsed string. ok is false if the position is unknown.
+func (e *FieldError) Span() (start, end int, ok bool) {
+	return e.Offset, e.Offset + e.Length, e.Length > 0
 }
 
 // NewFieldError creates a new FieldError
@@ -80,9 +113,11 @@ func NewFieldError(field FieldType, value, reason string) *FieldError {
 
 // RangeError represents an invalid range error
 type RangeError struct {
-	Field FieldType
-	Start int
-	End   int
+	Field  FieldType
+	Start  int
+	End    int
+	Offset int // Byte offset of the range in the parsed string
+	Length int // Byte length of the range in the parsed string, 0 if unknown
 }
 
 // Error implements the error interface
@@ -91,10 +126,18 @@ func (e *RangeError) Error() string {
 		e.Field, e.Start, e.End)
 }
 
+// Span returns the byte range [start, end) of the range in the parsed string.
+// ok is false if the position is unknown.
+func (e *RangeError) Span() (start, end int, ok bool) {
+	return e.Offset, e.Offset + e.Length, e.Length > 0
+}
+
 // StepError represents an invalid step value error
 type StepError struct {
-	Field FieldType
-	Step  int
+	Field  FieldType
+	Step   int
+	Offset int // Byte offset of the step value in the parsed string
+	Length int // Byte length of the step value in the parsed string, 0 if unknown
 }
 
 // Error implements the error interface
@@ -102,6 +145,66 @@ func (e *StepError) Error() string {
 	return fmt.Sprintf("invalid step value in %s field: %d (must be positive)", e.Field, e.Step)
 }
 
+// Span returns the byte range [start, end) of the step value in the parsed
+// string. ok is false if the position is unknown.
+func (e *StepError) Span() (start, end int, ok bool) {
+	return e.Offset, e.Offset + e.Length, e.Length > 0
+}
+
+// ParseErrors collects every problem found in an expression when parsing
+// with WithAllErrors
+//
+// It unwraps to the individual errors, so errors.As finds the first
+// *FieldError, *RangeError or *StepError and errors.Is matches sentinels.
+type ParseErrors struct {
+	Expression string  // The original expression that failed to parse
+	Errors     []error // Errors in the order they appear in the expression
+}
+
+// Error implements the error interface
+func (e *ParseErrors) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors in %q: %s", len(e.Errors), e.Expression, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the individual errors
+func (e *ParseErrors) Unwrap() []error {
+	return e.Errors
+}
+
+// shiftError moves the position of a parse error by delta bytes, used when
+// an error found in a substring is reported against the whole string
+func shiftError(err error, delta int) error {
+	switch e := err.(type) {
+	case *FieldError:
+		e.Offset += delta
+	case *ParseError:
+		e.Offset += delta
+	case *RangeError:
+		e.Offset += delta
+	case *StepError:
+		e.Offset += delta
+	}
+	return err
+}
+
+// PanicError is returned by the Recover job wrapper when a job panics
+type PanicError struct {
+	Value any    // The value passed to panic
+	Stack []byte // Stack trace of the panicking goroutine
+}
+
+// Error implements the error interface
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("job panicked: %v", e.Value)
+}
+
 // FieldType represents the type of cron field
 type FieldType string
 
@@ -141,3 +244,9 @@ func IsFieldError(err error) bool {
 	var fieldErr *FieldError
 	return errors.As(err, &fieldErr)
 }
+
+// IsPanicError checks if an error is a PanicError
+func IsPanicError(err error) bool {
+	var panicErr *PanicError
+	return errors.As(err, &panicErr)
+}
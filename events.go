@@ -0,0 +1,149 @@
+// events.go - Lifecycle events for runners built on Scheduler
+
+package expressparser
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// EventType identifies a point in the lifecycle of a scheduled job
+type EventType string
+
+const (
+	// EventScheduled is emitted when the next occurrence has been computed
+	EventScheduled EventType = "scheduled"
+
+	// EventStarted is emitted right before a job runs
+	EventStarted EventType = "started"
+
+	// EventFinished is emitted when a job returns without error
+	EventFinished EventType = "finished"
+
+	// EventFailed is emitted when a job returns an error
+	EventFailed EventType = "failed"
+
+	// EventSkipped is emitted when an occurrence is not run
+	EventSkipped EventType = "skipped"
+
+	// EventNoNextRun is emitted when Scheduler.Next returns ErrNoNextRun
+	EventNoNextRun EventType = "no_next_run"
+)
+
+// Reasons reported in Event.Reason for EventSkipped
+const (
+	// SkipLocked means another replica holds the occurrence
+	SkipLocked = "locked"
+
+	// SkipOverrun means the occurrences passed while a previous run was still
+	// going; one event covers them all, with their count in Event.Missed
+	SkipOverrun = "overrun"
+)
+
+// Event describes something that happened to a scheduled job
+type Event struct {
+	Type       EventType
+	JobID      string        // ID the job was registered under
+	Expression string        // Cron expression of the job's schedule
+	Timezone   string        // Name of the scheduler's location
+	Scheduled  time.Time     // Nominal occurrence time from Scheduler.Next
+	Start      time.Time     // Actual start time (started, finished, failed)
+	Duration   time.Duration // Run duration (finished, failed)
+	Reason     string        // Why the occurrence was skipped (skipped)
+	Missed     int           // Number of occurrences skipped, from Scheduled on (skipped)
+	Err        error         // Job error (failed) or scheduling error (no_next_run)
+}
+
+// NewEvent creates an event for a job scheduled by s, filling in the
+// expression and timezone
+func NewEvent(typ EventType, s *Scheduler, jobID string, scheduled time.Time) Event {
+	return Event{
+		Type:       typ,
+		JobID:      jobID,
+		Expression: s.Expression().String(),
+		Timezone:   s.Location().String(),
+		Scheduled:  scheduled,
+	}
+}
+
+// Lag returns how late the run started compared to its nominal time
+func (e Event) Lag() time.Duration {
+	if e.Start.IsZero() || e.Scheduled.IsZero() {
+		return 0
+	}
+	return e.Start.Sub(e.Scheduled)
+}
+
+// EventListener receives lifecycle events
+//
+// Listeners are called synchronously from the job's goroutine and must not
+// block for long.
+type EventListener interface {
+	OnEvent(Event)
+}
+
+// EventListenerFunc adapts a function to the EventListener interface
+type EventListenerFunc func(Event)
+
+// OnEvent calls f(e)
+func (f EventListenerFunc) OnEvent(e Event) {
+	f(e)
+}
+
+// SlogListener writes events as structured log records
+//
+// Failures are logged at error level, skips and ErrNoNextRun at warn level,
+// scheduling at debug level and everything else at info level.
+type SlogListener struct {
+	logger *slog.Logger
+}
+
+// NewSlogListener creates a listener logging to logger, or to slog.Default
+// when logger is nil
+func NewSlogListener(logger *slog.Logger) *SlogListener {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogListener{logger: logger}
+}
+
+// OnEvent logs e
+func (l *SlogListener) OnEvent(e Event) {
+	level := slog.LevelInfo
+	switch e.Type {
+	case EventScheduled:
+		level = slog.LevelDebug
+	case EventSkipped, EventNoNextRun:
+		level = slog.LevelWarn
+	case EventFailed:
+		level = slog.LevelError
+	}
+
+	attrs := []slog.Attr{
+		slog.String("event", string(e.Type)),
+		slog.String("job", e.JobID),
+		slog.String("expression", e.Expression),
+		slog.String("timezone", e.Timezone),
+	}
+	if !e.Scheduled.IsZero() {
+		attrs = append(attrs, slog.Time("scheduled", e.Scheduled))
+	}
+	if !e.Start.IsZero() {
+		attrs = append(attrs, slog.Time("start", e.Start), slog.Duration("lag", e.Lag()))
+	}
+	if e.Type == EventFinished || e.Type == EventFailed {
+		attrs = append(attrs, slog.Duration("duration", e.Duration))
+	}
+	if e.Reason != "" {
+		attrs = append(attrs, slog.String("reason", e.Reason))
+	}
+	if e.Missed > 1 {
+		attrs = append(attrs, slog.Int("missed", e.Missed))
+	}
+	if e.Err != nil {
+		attrs = append(attrs, slog.Any("error", e.Err))
+	}
+
+	l.logger.LogAttrs(context.Background(), level, "cron job "+string(e.Type), attrs...)
+}
@@ -0,0 +1,83 @@
+package expressparser
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewEvent(t *testing.T) {
+	schedule, err := NewScheduleInTimezone("0 9 * * 1-5", "America/New_York")
+	if err != nil {
+		t.Fatalf("NewScheduleInTimezone() error = %v", err)
+	}
+	scheduled := time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC)
+
+	ev := NewEvent(EventStarted, schedule.Scheduler(), "report", scheduled)
+	ev.Start = scheduled.Add(250 * time.Millisecond)
+
+	if ev.Expression != "0 9 * * 1-5" || ev.Timezone != "America/New_York" {
+		t.Errorf("NewEvent() = %+v, want expression and timezone from scheduler", ev)
+	}
+	if got := ev.Lag(); got != 250*time.Millisecond {
+		t.Errorf("Lag() = %v, want 250ms", got)
+	}
+}
+
+func TestRunner_Events(t *testing.T) {
+	var events []Event
+	listener := EventListenerFunc(func(e Event) { events = append(events, e) })
+
+	locker := NewMemoryLocker()
+	r := NewRunner(WithLocker(locker), WithEventListener(listener))
+	errBoom := errors.New("boom")
+	if err := r.Add("job", mustSchedule(t, "0 9 * * *"), func(ctx context.Context) error { return errBoom }); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	nominal := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	r.runOccurrence(context.Background(), r.entries[0], nominal)
+	r.runOccurrence(context.Background(), r.entries[0], nominal)
+
+	var types []string
+	for _, e := range events {
+		types = append(types, string(e.Type))
+	}
+	want := "started,failed,skipped"
+	if got := strings.Join(types, ","); got != want {
+		t.Fatalf("event types = %q, want %q", got, want)
+	}
+
+	if failed := events[1]; !errors.Is(failed.Err, errBoom) || !failed.Scheduled.Equal(nominal) || failed.Start.IsZero() {
+		t.Errorf("failed event = %+v", failed)
+	}
+	if skipped := events[2]; skipped.Reason != SkipLocked {
+		t.Errorf("skipped reason = %q, want %q", skipped.Reason, SkipLocked)
+	}
+}
+
+func TestSlogListener(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewSlogListener(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	scheduled := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	ev := NewEvent(EventFailed, NewScheduler(mustParseExpr(t, "0 9 * * *")), "report", scheduled)
+	ev.Start = scheduled.Add(time.Second)
+	ev.Duration = 3 * time.Second
+	ev.Err = errors.New("timeout")
+	l.OnEvent(ev)
+
+	out := buf.String()
+	for _, want := range []string{
+		"level=ERROR", `msg="cron job failed"`, "job=report", `expression="0 9 * * *"`,
+		"timezone=UTC", "scheduled=2024-01-01T09:00:00.000Z", "lag=1s", "duration=3s", "error=timeout",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// explain.go - Structured breakdown of expressions for tools and UIs
+
+package expressparser
+
+import (
+	"fmt"
+)
+
+// ExplanationVersion is the version of the Explanation JSON schema. It is
+// incremented when a key is renamed or removed or its meaning changes;
+// adding keys does not change it.
+const ExplanationVersion = 1
+
+// Dialect names the cron format an expression is written in
+type Dialect string
+
+// Dialects
+const (
+	DialectStandard Dialect = "standard" // five fields, minute to day of week
+	DialectExtended Dialect = "extended" // six fields, starting with seconds
+)
+
+// DayRule describes which day fields decide the days an expression runs on
+type DayRule string
+
+// Day rules
+const (
+	DayRuleAny        DayRule = "any"          // every day
+	DayRuleDayOfMonth DayRule = "day-of-month" // only the day of month is restricted
+	DayRuleDayOfWeek  DayRule = "day-of-week"  // only the day of week is restricted
+	DayRuleEither     DayRule = "either"       // both are restricted; a day matching either runs
+)
+
+// SpecialRuleKind identifies an L, W or # rule of a day field
+type SpecialRuleKind string
+
+// Special rule kinds
+const (
+	SpecialLastDay        SpecialRuleKind = "last-day"        // L: last day of the month
+	SpecialLastWeekday    SpecialRuleKind = "last-weekday"    // LW: last Monday to Friday of the month
+	SpecialBeforeLastDay  SpecialRuleKind = "before-last-day" // L-N: N days before the last day
+	SpecialNearestWeekday SpecialRuleKind = "nearest-weekday" // NW: Monday to Friday nearest day N
+	SpecialLastOccurrence SpecialRuleKind = "last-occurrence" // NL: last given weekday of the month
+	SpecialNthOccurrence  SpecialRuleKind = "nth-occurrence"  // N#M: Mth given weekday of the month
+)
+
+// Explanation is a machine-readable breakdown of an expression
+//
+// It marshals to JSON with the keys given in its tags, which are stable for
+// a given Version.
+type Explanation struct {
+	Version     int                `json:"version"`     // ExplanationVersion
+	Expression  string             `json:"expression"`  // the expression in cron syntax
+	Dialect     Dialect            `json:"dialect"`     // standard or extended
+	Description string             `json:"description"` // full human-readable description
+	DayRule     DayRule            `json:"day_rule"`    // how the day fields combine
+	Fields      []FieldExplanation `json:"fields"`      // seconds (extended only) to day of week
+}
+
+// FieldExplanation is the breakdown of one field of an expression
+type FieldExplanation struct {
+	Field       FieldType     `json:"field"`       // e.g. "minute"
+	Raw         string        `json:"raw"`         // the field as written, e.g. "*/15"
+	Values      []int         `json:"values"`      // plain values matched, ascending
+	Any         bool          `json:"any"`         // true if every value in range matches
+	Special     []SpecialRule `json:"special"`     // L, W and # rules, in canonical order
+	Description string        `json:"description"` // description of this field alone, empty if Any
+}
+
+// SpecialRule is an L, W or # rule of a day field
+//
+// Only the numbers meaningful for Kind are set; the others are zero.
+type SpecialRule struct {
+	Kind       SpecialRuleKind `json:"kind"`
+	Token      string          `json:"token"`      // the rule in cron syntax, e.g. "15W" or "1#2"
+	Day        int             `json:"day"`        // day of month for nearest-weekday, days before the last for before-last-day
+	Weekday    int             `json:"weekday"`    // 0 (Sunday) to 6 for last-occurrence and nth-occurrence
+	Occurrence int             `json:"occurrence"` // 1 to 5 for nth-occurrence
+}
+
+// Explain returns a structured breakdown of the expression. opts localise
+// the descriptions, as for Describe.
+//
+// Example:
+//
+//	data, _ := json.Marshal(expressparser.MustParse("0 9 L * *").Explain())
+func (e *Expression) Explain(opts ...DescriptionOptions) *Explanation {
+	d := NewDescriptor(e, opts...)
+
+	ex := &Explanation{
+		Version:     ExplanationVersion,
+		Expression:  e.String(),
+		Dialect:     DialectStandard,
+		Description: d.Describe(),
+		DayRule:     DayRuleAny,
+	}
+
+	fields := []*Field{e.Minute, e.Hour, e.DayOfMonth, e.Month, e.DayOfWeek}
+	if e.Type == ExtendedCron {
+		ex.Dialect = DialectExtended
+		fields = append([]*Field{e.Second}, fields...)
+	}
+	for _, f := range fields {
+		ex.Fields = append(ex.Fields, FieldExplanation{
+			Field:       f.Type,
+			Raw:         f.Raw,
+			Values:      f.All(),
+			Any:         f.IsAll(),
+			Special:     d.specialRules(f),
+			Description: d.describeField(f),
+		})
+	}
+
+	switch domAll, dowAll := e.DayOfMonth.IsAll(), e.DayOfWeek.IsAll(); {
+	case !domAll && !dowAll:
+		ex.DayRule = DayRuleEither
+	case !domAll:
+		ex.DayRule = DayRuleDayOfMonth
+	case !dowAll:
+		ex.DayRule = DayRuleDayOfWeek
+	}
+
+	return ex
+}
+
+// specialRules returns the L, W and # rules of a field
+func (d *Descriptor) specialRules(f *Field) []SpecialRule {
+	rules := make([]SpecialRule, 0)
+	if f.Type != FieldDayOfMonth && f.Type != FieldDayOfWeek {
+		return rules
+	}
+
+	for _, v := range d.specialValues(f) {
+		var r SpecialRule
+		switch {
+		case f.Type == FieldDayOfWeek && v >= 10 && v <= 16:
+			r = SpecialRule{Kind: SpecialLastOccurrence, Token: fmt.Sprintf("%dL", v-10), Weekday: v - 10}
+		case f.Type == FieldDayOfWeek && v >= 21 && v <= 75:
+			weekday, occurrence := (v-20)/10, (v-20)%10
+			r = SpecialRule{Kind: SpecialNthOccurrence, Token: fmt.Sprintf("%d#%d", weekday, occurrence),
+				Weekday: weekday, Occurrence: occurrence}
+		case f.Type == FieldDayOfWeek:
+			continue
+		case v == 32:
+			r = SpecialRule{Kind: SpecialLastDay, Token: "L"}
+		case v == lastWeekdayValue:
+			r = SpecialRule{Kind: SpecialLastWeekday, Token: "LW"}
+		case v > 32 && v < lastWeekdayValue:
+			r = SpecialRule{Kind: SpecialBeforeLastDay, Token: fmt.Sprintf("L-%d", v-32), Day: v - 32}
+		case v >= 101 && v <= 131:
+			r = SpecialRule{Kind: SpecialNearestWeekday, Token: fmt.Sprintf("%dW", v-100), Day: v - 100}
+		default:
+			continue
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+// describeField describes a single field on its own, or returns "" if the
+// field matches every value
+func (d *Descriptor) describeField(f *Field) string {
+	if f.IsAll() {
+		return ""
+	}
+
+	values := f.All()
+	switch f.Type {
+	case FieldSecond:
+		if desc, ok := d.describeEvery(f, values,
+			PhraseEverySecond, PhraseEveryNSeconds, PhraseEveryNSecondsFrom, PhraseSecondRange); ok {
+			return desc
+		}
+		return d.locale.phrase(PhraseAtSeconds, d.formatList(values))
+	case FieldMinute:
+		if desc, ok := d.describeEvery(f, values,
+			PhraseEveryMinute, PhraseEveryNMinutes, PhraseEveryNMinutesFrom, PhraseMinuteRange); ok {
+			return desc
+		}
+		return d.locale.phrase(PhraseMinutesOfEveryHour, d.formatList(values))
+	case FieldHour:
+		if len(values) > 1 && isConsecutive(values) && !d.opts.Verbose {
+			return d.locale.phrase(PhraseBetween,
+				d.formatTime(values[0], 0), d.formatTime(values[len(values)-1], 59))
+		}
+		if desc, ok := d.describeHourSteps(0); ok {
+			return desc
+		}
+		return d.locale.phrase(PhraseAt, d.formatHours(values))
+	case FieldDayOfMonth:
+		return d.describeDayOfMonth()
+	case FieldMonth:
+		return d.describeMonth()
+	case FieldDayOfWeek:
+		return d.describeDayOfWeek()
+	}
+	return ""
+}
@@ -0,0 +1,119 @@
+// explain_test.go - Tests for structured expression breakdowns
+
+package expressparser
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestExpression_Explain(t *testing.T) {
+	ex := MustParse("*/15 9-17 L,15W * MON-FRI,5L").Explain()
+
+	if ex.Version != ExplanationVersion || ex.Dialect != DialectStandard || ex.DayRule != DayRuleEither {
+		t.Errorf("Explain() = version %d, dialect %s, day rule %s", ex.Version, ex.Dialect, ex.DayRule)
+	}
+	if len(ex.Fields) != 5 {
+		t.Fatalf("len(Fields) = %d, want 5", len(ex.Fields))
+	}
+
+	minute := ex.Fields[0]
+	if minute.Field != FieldMinute || minute.Raw != "*/15" || minute.Description != "every 15 minutes" ||
+		!reflect.DeepEqual(minute.Values, []int{0, 15, 30, 45}) {
+		t.Errorf("minute = %+v", minute)
+	}
+	if hour := ex.Fields[1]; hour.Description != "between 9:00 AM and 5:59 PM" {
+		t.Errorf("hour description = %q", hour.Description)
+	}
+
+	dom := ex.Fields[2]
+	wantDOM := []SpecialRule{
+		{Kind: SpecialLastDay, Token: "L"},
+		{Kind: SpecialNearestWeekday, Token: "15W", Day: 15},
+	}
+	if len(dom.Values) != 0 || !reflect.DeepEqual(dom.Special, wantDOM) {
+		t.Errorf("day-of-month = %+v", dom)
+	}
+
+	if month := ex.Fields[3]; !month.Any || month.Description != "" || len(month.Values) != 12 {
+		t.Errorf("month = %+v", month)
+	}
+
+	dow := ex.Fields[4]
+	wantDOW := []SpecialRule{{Kind: SpecialLastOccurrence, Token: "5L", Weekday: 5}}
+	if !reflect.DeepEqual(dow.Values, []int{1, 2, 3, 4, 5}) || !reflect.DeepEqual(dow.Special, wantDOW) ||
+		dow.Description != "on weekdays and on the last Friday of the month" {
+		t.Errorf("day-of-week = %+v", dow)
+	}
+}
+
+func TestExpression_ExplainSpecialRules(t *testing.T) {
+	tests := []struct {
+		expr string
+		want SpecialRule
+	}{
+		{"0 0 LW * *", SpecialRule{Kind: SpecialLastWeekday, Token: "LW"}},
+		{"0 0 L-3 * *", SpecialRule{Kind: SpecialBeforeLastDay, Token: "L-3", Day: 3}},
+		{"0 0 L-1 * *", SpecialRule{Kind: SpecialBeforeLastDay, Token: "L-1", Day: 1}},
+		{"0 0 * * 1#2", SpecialRule{Kind: SpecialNthOccurrence, Token: "1#2", Weekday: 1, Occurrence: 2}},
+		{"0 0 * * 0L", SpecialRule{Kind: SpecialLastOccurrence, Token: "0L", Weekday: 0}},
+	}
+
+	for _, tt := range tests {
+		var special []SpecialRule
+		for _, f := range MustParse(tt.expr).Explain().Fields {
+			special = append(special, f.Special...)
+		}
+		if len(special) != 1 || special[0] != tt.want {
+			t.Errorf("Explain(%q) special = %+v, want %+v", tt.expr, special, tt.want)
+		}
+	}
+}
+
+func TestExpression_ExplainExtended(t *testing.T) {
+	ex := MustParse("30 0 9 * * 1").Explain(DescriptionOptions{Locale: "de"})
+
+	if ex.Dialect != DialectExtended || ex.DayRule != DayRuleDayOfWeek {
+		t.Errorf("dialect = %s, day rule = %s", ex.Dialect, ex.DayRule)
+	}
+	if len(ex.Fields) != 6 || ex.Fields[0].Field != FieldSecond {
+		t.Fatalf("Fields = %+v", ex.Fields)
+	}
+	if want := NewDescriptor(MustParse("30 0 9 * * 1"), DescriptionOptions{Locale: "de"}).Describe(); ex.Description != want {
+		t.Errorf("Description = %q, want %q", ex.Description, want)
+	}
+}
+
+func TestExpression_ExplainJSON(t *testing.T) {
+	data, err := json.Marshal(MustParse("0 12 * * 1#1").Explain())
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	for _, key := range []string{"version", "expression", "dialect", "description", "day_rule", "fields"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("JSON missing key %q: %s", key, data)
+		}
+	}
+
+	fields := got["fields"].([]any)
+	dow := fields[4].(map[string]any)
+	for _, key := range []string{"field", "raw", "values", "any", "special", "description"} {
+		if _, ok := dow[key]; !ok {
+			t.Errorf("field JSON missing key %q: %v", key, dow)
+		}
+	}
+	rule := dow["special"].([]any)[0].(map[string]any)
+	want := map[string]any{"kind": "nth-occurrence", "token": "1#1", "day": 0.0, "weekday": 1.0, "occurrence": 1.0}
+	if !reflect.DeepEqual(rule, want) {
+		t.Errorf("special rule = %v, want %v", rule, want)
+	}
+	if values := dow["values"].([]any); len(values) != 0 {
+		t.Errorf("values = %v, want []", values)
+	}
+}
@@ -6,12 +6,21 @@ import (
 )
 
 // Field represents a parsed cron field with all valid values
+//
+// Values holds plain values as themselves. Special day values are encoded
+// above the field's range: in the day of month, L is 32, L-N is 32+N, LW is
+// 99 and NW is 100+N; in the day of week, NL is 10+N and N#M is 20+N*10+M.
+// Before LW moved to 99 it was stored as 33, the same value as L-1.
 type Field struct {
 	Type   FieldType
 	Values map[int]bool
 	Raw    string
 }
 
+// lastWeekdayValue encodes LW in a day-of-month field. L is stored as 32,
+// L-N as 32+N and NW as 100+N, so LW sits above the largest L-N (L-30).
+const lastWeekdayValue = 99
+
 func NewField(fieldType FieldType) *Field {
 	return &Field{
 		Type:   fieldType,
@@ -77,11 +86,21 @@ func NewFieldParser(fieldType FieldType) *FieldParser {
 }
 
 func (p *FieldParser) Parse(expr string) (*Field, error) {
+	field, errs := p.parse(expr, false)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return field, nil
+}
+
+// parse parses a field, stopping at the first invalid part unless all is set,
+// in which case every comma-separated part is checked and all errors returned
+func (p *FieldParser) parse(expr string, all bool) (*Field, []error) {
 	field := NewField(p.fieldType)
 	field.Raw = expr
 
 	if expr == "" {
-		return nil, NewFieldError(p.fieldType, expr, "field cannot be empty")
+		return nil, []error{NewFieldError(p.fieldType, expr, "field cannot be empty")}
 	}
 
 	if expr == "*" || expr == "?" {
@@ -89,24 +108,60 @@ func (p *FieldParser) Parse(expr string) (*Field, error) {
 		return field, nil
 	}
 
-	parts := strings.Split(expr, ",")
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		if part == "" {
+	var errs []error
+	offset := 0
+	for _, part := range strings.Split(expr, ",") {
+		start := offset
+		offset += len(part) + 1
+
+		trimmed := strings.TrimSpace(part)
+		if trimmed == "" {
 			continue
 		}
-		if err := p.parsePart(field, part); err != nil {
-			return nil, err
+		start += strings.Index(part, trimmed)
+		if err := p.parsePart(field, trimmed); err != nil {
+			errs = append(errs, locateError(err, trimmed, start))
+			if !all {
+				return nil, errs
+			}
 		}
 	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
 
 	if len(field.Values) == 0 {
-		return nil, NewFieldError(p.fieldType, expr, "no valid values found")
+		err := NewFieldError(p.fieldType, expr, "no valid values found")
+		err.Length = len(expr)
+		return nil, []error{err}
 	}
 
 	return field, nil
 }
 
+// locateError records where in the field an error from parsePart occurred.
+// part is the comma-separated part that failed and start its offset.
+func locateError(err error, part string, start int) error {
+	switch e := err.(type) {
+	case *FieldError:
+		idx := -1
+		if e.Value != "" {
+			idx = strings.Index(strings.ToUpper(part), strings.ToUpper(e.Value))
+		}
+		if idx >= 0 {
+			e.Offset, e.Length = start+idx, len(e.Value)
+		} else {
+			e.Offset, e.Length = start, len(part)
+		}
+	case *RangeError:
+		e.Offset, e.Length = start, len(part)
+	case *StepError:
+		slash := strings.Index(part, "/")
+		e.Offset, e.Length = start+slash+1, len(part)-slash-1
+	}
+	return err
+}
+
 func (p *FieldParser) parsePart(field *Field, part string) error {
 	// Check for step value first (e.g., "*/5" or "10-20/2")
 	if strings.Contains(part, "/") {
@@ -286,7 +341,7 @@ func (p *FieldParser) parseDayOfMonthSpecial(field *Field, part string) error {
 		return nil
 	}
 	if part == "LW" {
-		field.Values[33] = true
+		field.Values[lastWeekdayValue] = true
 		return nil
 	}
 	if strings.HasPrefix(part, "L-") {
@@ -364,7 +419,14 @@ func (p *FieldParser) parseValue(s string) (int, error) {
 
 	value, err := strconv.Atoi(s)
 	if err != nil {
-		return 0, NewFieldError(p.fieldType, s, "invalid value")
+		fieldErr := NewFieldError(p.fieldType, s, "invalid value")
+		switch p.fieldType {
+		case FieldMonth:
+			fieldErr.Suggestion = suggest(s, monthNames)
+		case FieldDayOfWeek:
+			fieldErr.Suggestion = suggest(s, dayNames)
+		}
+		return 0, fieldErr
 	}
 	return value, nil
 }
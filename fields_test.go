@@ -557,7 +557,7 @@ func TestFieldParser_ParseSpecialCharacters(t *testing.T) {
 			name:      "LW for last weekday",
 			fieldType: FieldDayOfMonth,
 			expr:      "LW",
-			checkFunc: func(f *Field) bool { return f.Values[33] },
+			checkFunc: func(f *Field) bool { return f.Values[lastWeekdayValue] },
 			wantErr:   false,
 		},
 		{
@@ -740,3 +740,19 @@ func intSliceEqual(a, b []int) bool {
 	}
 	return true
 }
+
+func TestFieldParser_ErrorOffset(t *testing.T) {
+	_, err := NewFieldParser(FieldMonth).Parse("JAN,FEBB")
+	fieldErr, ok := err.(*FieldError)
+	if !ok {
+		t.Fatalf("error = %v, want *FieldError", err)
+	}
+
+	start, end, known := fieldErr.Span()
+	if !known || start != 4 || end != 8 {
+		t.Errorf("Span() = %d, %d, %v, want 4, 8, true", start, end, known)
+	}
+	if fieldErr.Suggestion != "FEB" {
+		t.Errorf("Suggestion = %q, want %q", fieldErr.Suggestion, "FEB")
+	}
+}
@@ -0,0 +1,354 @@
+// lint.go - Diagnostics for surprising but valid cron expressions
+
+package expressparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Severity ranks how likely a diagnostic points to a real problem
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// rank orders severities from info (0) to error (2)
+func (s Severity) rank() int {
+	switch s {
+	case SeverityError:
+		return 2
+	case SeverityWarning:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// AtLeast reports whether s is as severe as other or more
+func (s Severity) AtLeast(other Severity) bool {
+	return s.rank() >= other.rank()
+}
+
+// LintCode identifies the kind of problem a diagnostic reports
+type LintCode string
+
+const (
+	// LintWildcardMinute: minute (or second) is * while a coarser field is
+	// restricted, e.g. "* 9 * * *" runs 60 times between 9:00 and 9:59
+	LintWildcardMinute LintCode = "wildcard-minute"
+
+	// LintDayOrSemantics: day-of-month and day-of-week are both restricted, so
+	// the expression fires when either matches, not when both do
+	LintDayOrSemantics LintCode = "dom-dow-or"
+
+	// LintSkippedMonths: some selected months have none of the selected days,
+	// e.g. "0 0 31 * *" never fires in April
+	LintSkippedMonths LintCode = "skipped-months"
+
+	// LintNeverRuns: no selected month has any of the selected days,
+	// e.g. "0 0 30 2 *"
+	LintNeverRuns LintCode = "never-runs"
+
+	// LintUnevenStep: a step does not divide the field's cycle, e.g. "*/7"
+	// minutes leaves a 4 minute gap between :56 and :00
+	LintUnevenStep LintCode = "uneven-step"
+
+	// LintFifthWeekday: "#5" only exists in some months
+	LintFifthWeekday LintCode = "fifth-weekday"
+
+	// LintSecondsUnsupported: the expression has a seconds field but the
+	// target executor only understands five fields
+	LintSecondsUnsupported LintCode = "seconds-unsupported"
+)
+
+// Diagnostic is a single finding reported by Lint
+type Diagnostic struct {
+	Code       LintCode  `json:"code"`
+	Severity   Severity  `json:"severity"`
+	Field      FieldType `json:"field,omitempty"` // Field the finding is about, if any
+	Message    string    `json:"message"`
+	Suggestion string    `json:"suggestion,omitempty"` // How to address the finding
+	Fix        string    `json:"fix,omitempty"`        // Replacement expression, when one applies
+}
+
+// String formats the diagnostic as "severity[code] message"
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s[%s] %s", d.Severity, d.Code, d.Message)
+}
+
+type linter struct {
+	fiveField bool
+}
+
+// LintOption configures Lint
+type LintOption func(*linter)
+
+// WithFiveFieldExecutor reports expressions that use a seconds field, for
+// schedules that will be run by a classic 5-field cron daemon
+func WithFiveFieldExecutor() LintOption {
+	return func(l *linter) {
+		l.fiveField = true
+	}
+}
+
+// Lint parses expr and reports valid but surprising constructs
+//
+// The returned error is the parse error if expr is not valid.
+//
+// Example:
+//
+//	diags, err := expressparser.Lint("* 9 * * *")
+//	// diags[0].Code == LintWildcardMinute, diags[0].Fix == "0 9 * * *"
+func Lint(expr string, opts ...LintOption) ([]Diagnostic, error) {
+	e, err := Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return e.Lint(opts...), nil
+}
+
+// Lint reports valid but surprising constructs in the expression
+func (e *Expression) Lint(opts ...LintOption) []Diagnostic {
+	l := &linter{}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	var diags []Diagnostic
+	diags = append(diags, l.checkSeconds(e)...)
+	diags = append(diags, l.checkWildcards(e)...)
+	diags = append(diags, l.checkDayFields(e)...)
+	diags = append(diags, l.checkMonthDays(e)...)
+	diags = append(diags, l.checkSteps(e)...)
+	return diags
+}
+
+// withField returns the expression's fields with one field replaced
+func withField(e *Expression, field FieldType, raw string) string {
+	fields := []*Field{e.Minute, e.Hour, e.DayOfMonth, e.Month, e.DayOfWeek}
+	if e.IsExtended() {
+		fields = append([]*Field{e.Second}, fields...)
+	}
+
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		parts[i] = f.Raw
+		if f.Type == field {
+			parts[i] = raw
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func (l *linter) checkSeconds(e *Expression) []Diagnostic {
+	if !l.fiveField || !e.IsExtended() {
+		return nil
+	}
+
+	d := Diagnostic{
+		Code:     LintSecondsUnsupported,
+		Severity: SeverityError,
+		Field:    FieldSecond,
+		Message:  "expression has a seconds field but the executor only supports five fields",
+	}
+	if e.Second.Contains(0) && len(e.Second.Values) == 1 {
+		d.Suggestion = "drop the seconds field"
+		d.Fix = strings.Join(e.FieldStrings()[1:], " ")
+	} else {
+		d.Suggestion = "run at minute granularity or use an executor that supports seconds"
+	}
+	return []Diagnostic{d}
+}
+
+func (l *linter) checkWildcards(e *Expression) []Diagnostic {
+	var diags []Diagnostic
+
+	if e.Minute.IsAll() && !e.Hour.IsAll() {
+		runs := 60
+		if e.IsExtended() {
+			runs *= len(e.Second.Values)
+		}
+		diags = append(diags, Diagnostic{
+			Code:     LintWildcardMinute,
+			Severity: SeverityWarning,
+			Field:    FieldMinute,
+			Message: fmt.Sprintf("minute is %q while hour is %q, so the job runs %d times in each matching hour",
+				e.Minute.Raw, e.Hour.Raw, runs),
+			Suggestion: "set the minute field to run once per hour",
+			Fix:        withField(e, FieldMinute, "0"),
+		})
+	}
+
+	if e.IsExtended() && e.Second.IsAll() && !e.Minute.IsAll() {
+		diags = append(diags, Diagnostic{
+			Code:     LintWildcardMinute,
+			Severity: SeverityWarning,
+			Field:    FieldSecond,
+			Message: fmt.Sprintf("second is %q while minute is %q, so the job runs 60 times in each matching minute",
+				e.Second.Raw, e.Minute.Raw),
+			Suggestion: "set the second field to run once per minute",
+			Fix:        withField(e, FieldSecond, "0"),
+		})
+	}
+
+	return diags
+}
+
+func (l *linter) checkDayFields(e *Expression) []Diagnostic {
+	if e.DayOfMonth.IsAll() || e.DayOfWeek.IsAll() {
+		return nil
+	}
+
+	return []Diagnostic{{
+		Code:     LintDayOrSemantics,
+		Severity: SeverityWarning,
+		Field:    FieldDayOfWeek,
+		Message: fmt.Sprintf("day-of-month %q and day-of-week %q are both restricted; the job runs on days matching either, not both",
+			e.DayOfMonth.Raw, e.DayOfWeek.Raw),
+		Suggestion: "restrict only one day field, or use N#M in day-of-week for \"first Monday\" style schedules",
+	}}
+}
+
+// maxDaysInMonth is the longest length of each month, counting leap years
+var maxDaysInMonth = [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+func (l *linter) checkMonthDays(e *Expression) []Diagnostic {
+	var diags []Diagnostic
+
+	for v := range e.DayOfWeek.Values {
+		if v >= 21 && v <= 75 && (v-20)%10 == 5 {
+			diags = append(diags, Diagnostic{
+				Code:       LintFifthWeekday,
+				Severity:   SeverityInfo,
+				Field:      FieldDayOfWeek,
+				Message:    fmt.Sprintf("day-of-week %q uses #5, which does not occur in every month", e.DayOfWeek.Raw),
+				Suggestion: "use NL for the last occurrence of a weekday in every month",
+			})
+			break
+		}
+	}
+
+	// Day-of-week matches can fill any month, and special day-of-month values
+	// adapt to the month length
+	if !e.DayOfWeek.IsAll() || e.DayOfMonth.IsAll() || e.HasLastDayOfMonth || e.HasLastWeekday ||
+		e.HasLastDayOffset {
+		return diags
+	}
+
+	var skipped []string
+	months := e.GetMonths()
+	for _, m := range months {
+		fits := false
+		for v := range e.DayOfMonth.Values {
+			// NW is clamped to the last day of short months
+			if v > 100 || v <= maxDaysInMonth[m] {
+				fits = true
+				break
+			}
+		}
+		if !fits {
+			skipped = append(skipped, monthToName(m))
+		}
+	}
+
+	switch {
+	case len(skipped) == 0:
+	case len(skipped) == len(months):
+		diags = append(diags, Diagnostic{
+			Code:       LintNeverRuns,
+			Severity:   SeverityError,
+			Field:      FieldDayOfMonth,
+			Message:    fmt.Sprintf("day-of-month %q never occurs in month %q; the expression never runs", e.DayOfMonth.Raw, e.Month.Raw),
+			Suggestion: "use L for the last day of the month",
+			Fix:        withField(e, FieldDayOfMonth, "L"),
+		})
+	default:
+		diags = append(diags, Diagnostic{
+			Code:     LintSkippedMonths,
+			Severity: SeverityWarning,
+			Field:    FieldDayOfMonth,
+			Message: fmt.Sprintf("day-of-month %q does not occur in %s, so those months are skipped",
+				e.DayOfMonth.Raw, joinWords(skipped)),
+			Suggestion: "use L to run on the last day of every month",
+			Fix:        withField(e, FieldDayOfMonth, "L"),
+		})
+	}
+
+	return diags
+}
+
+func (l *linter) checkSteps(e *Expression) []Diagnostic {
+	var diags []Diagnostic
+
+	fields := []*Field{e.Minute, e.Hour}
+	if e.IsExtended() {
+		fields = append([]*Field{e.Second}, fields...)
+	}
+
+	for _, f := range fields {
+		bounds := fieldBounds[f.Type]
+		cycle := bounds.max - bounds.min + 1
+
+		for _, part := range strings.Split(f.Raw, ",") {
+			base, stepStr, ok := strings.Cut(part, "/")
+			if !ok {
+				continue
+			}
+			step, err := strconv.Atoi(stepStr)
+			if err != nil || step <= 0 || cycle%step == 0 {
+				continue
+			}
+			// Only a step over the whole cycle wraps around unevenly
+			if base != "*" && base != strconv.Itoa(bounds.min) && base != fmt.Sprintf("%d-%d", bounds.min, bounds.max) {
+				continue
+			}
+
+			last := bounds.min + (cycle-1)/step*step
+			gap := bounds.max - last + 1
+			lower, upper := nearestDivisors(cycle, step)
+
+			diags = append(diags, Diagnostic{
+				Code:     LintUnevenStep,
+				Severity: SeverityWarning,
+				Field:    f.Type,
+				Message: fmt.Sprintf("step %q does not divide %d evenly: after %d the next run is %d later, not %d",
+					part, cycle, last, gap, step),
+				Suggestion: fmt.Sprintf("use */%d or */%d for evenly spaced runs, or list the exact values", lower, upper),
+				Fix:        withField(e, f.Type, strings.Replace(f.Raw, part, "*/"+strconv.Itoa(lower), 1)),
+			})
+		}
+	}
+
+	return diags
+}
+
+// nearestDivisors returns the closest divisors of n below and above step
+func nearestDivisors(n, step int) (lower, upper int) {
+	lower, upper = 1, n
+	for d := step - 1; d >= 1; d-- {
+		if n%d == 0 {
+			lower = d
+			break
+		}
+	}
+	for d := step + 1; d <= n; d++ {
+		if n%d == 0 {
+			upper = d
+			break
+		}
+	}
+	return lower, upper
+}
+
+// joinWords joins words as "a", "a and b" or "a, b and c"
+func joinWords(words []string) string {
+	if len(words) <= 1 {
+		return strings.Join(words, "")
+	}
+	return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+}
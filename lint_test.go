@@ -0,0 +1,145 @@
+package expressparser
+
+import (
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		opts     []LintOption
+		code     LintCode
+		severity Severity
+		field    FieldType
+		fix      string
+	}{
+		{
+			name:     "wildcard minute",
+			expr:     "* 9 * * *",
+			code:     LintWildcardMinute,
+			severity: SeverityWarning,
+			field:    FieldMinute,
+			fix:      "0 9 * * *",
+		},
+		{
+			name:     "wildcard second",
+			expr:     "* 0 9 * * *",
+			code:     LintWildcardMinute,
+			severity: SeverityWarning,
+			field:    FieldSecond,
+			fix:      "0 0 9 * * *",
+		},
+		{
+			name:     "dom and dow both restricted",
+			expr:     "0 0 1 * 1",
+			code:     LintDayOrSemantics,
+			severity: SeverityWarning,
+			field:    FieldDayOfWeek,
+		},
+		{
+			name:     "31st skips short months",
+			expr:     "0 0 31 * *",
+			code:     LintSkippedMonths,
+			severity: SeverityWarning,
+			field:    FieldDayOfMonth,
+			fix:      "0 0 L * *",
+		},
+		{
+			name:     "february 30th never runs",
+			expr:     "0 0 30 2 *",
+			code:     LintNeverRuns,
+			severity: SeverityError,
+			field:    FieldDayOfMonth,
+			fix:      "0 0 L 2 *",
+		},
+		{
+			name:     "uneven minute step",
+			expr:     "*/7 * * * *",
+			code:     LintUnevenStep,
+			severity: SeverityWarning,
+			field:    FieldMinute,
+			fix:      "*/6 * * * *",
+		},
+		{
+			name:     "uneven hour step",
+			expr:     "0 */5 * * *",
+			code:     LintUnevenStep,
+			severity: SeverityWarning,
+			field:    FieldHour,
+			fix:      "0 */4 * * *",
+		},
+		{
+			name:     "fifth weekday",
+			expr:     "0 9 * * 5#5",
+			code:     LintFifthWeekday,
+			severity: SeverityInfo,
+			field:    FieldDayOfWeek,
+		},
+		{
+			name:     "seconds with five-field executor",
+			expr:     "0 30 9 * * *",
+			opts:     []LintOption{WithFiveFieldExecutor()},
+			code:     LintSecondsUnsupported,
+			severity: SeverityError,
+			field:    FieldSecond,
+			fix:      "30 9 * * *",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags, err := Lint(tt.expr, tt.opts...)
+			if err != nil {
+				t.Fatalf("Lint() error = %v", err)
+			}
+			if len(diags) != 1 {
+				t.Fatalf("Lint() = %v, want exactly one diagnostic", diags)
+			}
+
+			d := diags[0]
+			if d.Code != tt.code || d.Severity != tt.severity || d.Field != tt.field || d.Fix != tt.fix {
+				t.Errorf("Lint() = %+v, want code %s, severity %s, field %s, fix %q",
+					d, tt.code, tt.severity, tt.field, tt.fix)
+			}
+			if d.Message == "" || d.Suggestion == "" {
+				t.Errorf("Lint() = %+v, want message and suggestion", d)
+			}
+		})
+	}
+}
+
+func TestLint_Clean(t *testing.T) {
+	clean := []string{
+		"0 9 * * 1-5",
+		"*/15 * * * *",
+		"0 0 L * *",
+		"0 0 30W 2 *",
+		"0 0 31 1,3,5 *",
+		"0-30/7 * * * *",
+		"0 0 1 * *",
+		"30 9 * * *",
+	}
+
+	for _, expr := range clean {
+		diags, err := Lint(expr, WithFiveFieldExecutor())
+		if err != nil {
+			t.Fatalf("Lint(%q) error = %v", expr, err)
+		}
+		if len(diags) != 0 {
+			t.Errorf("Lint(%q) = %v, want no diagnostics", expr, diags)
+		}
+	}
+}
+
+func TestLint_InvalidExpression(t *testing.T) {
+	if _, err := Lint("61 * * * *"); !IsFieldError(err) {
+		t.Errorf("Lint() error = %v, want FieldError", err)
+	}
+}
+
+func TestSeverity_AtLeast(t *testing.T) {
+	if !SeverityError.AtLeast(SeverityWarning) || SeverityInfo.AtLeast(SeverityWarning) || !SeverityWarning.AtLeast(SeverityWarning) {
+		t.Errorf("AtLeast ordering is wrong")
+	}
+}
@@ -0,0 +1,339 @@
+// locale.go - Locale catalog for human-readable descriptions
+
+package expressparser
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Phrase identifies a phrase template in a Locale
+//
+// Templates are fmt format strings. Arguments are passed in the order given
+// in each constant's comment; use explicit argument indexes such as %[2]s to
+// reorder them for a language's word order.
+type Phrase string
+
+const (
+	PhraseEverySecond          Phrase = "every_second"            // "every second"
+	PhraseSecondsOfEveryMinute Phrase = "seconds_of_every_minute" // seconds list
+	PhraseEveryMinute          Phrase = "every_minute"            // "every minute"
+	PhraseEveryHour            Phrase = "every_hour"              // "every hour"
+	PhraseMinutePastEveryHour  Phrase = "minute_past_every_hour"  // minute number
+	PhraseMinutesOfEveryHour   Phrase = "minutes_of_every_hour"   // minutes list
+	PhraseAt                   Phrase = "at"                      // time or times list
+	PhraseAtWithSeconds        Phrase = "at_with_seconds"         // time, second number
+	PhraseMinutesOfHours       Phrase = "minutes_of_hours"        // minutes list, hours list
+	PhraseMinutesDuringHours   Phrase = "minutes_during_hours"    // minutes list, hours list
+	PhraseAtSeconds            Phrase = "at_seconds"              // seconds list
+	PhraseAtMinutes            Phrase = "at_minutes"              // minutes list, before stepped hours
+
+	PhraseEveryNSeconds        Phrase = "every_n_seconds"         // step
+	PhraseEveryNSecondsFrom    Phrase = "every_n_seconds_from"    // step, first second
+	PhraseEveryNMinutes        Phrase = "every_n_minutes"         // step
+	PhraseEveryNMinutesFrom    Phrase = "every_n_minutes_from"    // step, first minute
+	PhraseEveryNHours          Phrase = "every_n_hours"           // step
+	PhraseEveryNHoursFrom      Phrase = "every_n_hours_from"      // step, first time
+	PhraseSecondRange          Phrase = "second_range"            // first and last second
+	PhraseMinuteRange          Phrase = "minute_range"            // first and last minute
+	PhraseBetween              Phrase = "between"                 // first and last time
+	PhraseMinuteOfHoursBetween Phrase = "minute_of_hours_between" // minute number, first and last time
+
+	PhraseInTimezone            Phrase = "in_timezone"              // description, timezone name
+	PhraseViewerTime            Phrase = "viewer_time"              // time, viewer time, viewer timezone
+	PhraseViewerTimeNextDay     Phrase = "viewer_time_next_day"     // time, viewer time, viewer timezone
+	PhraseViewerTimePreviousDay Phrase = "viewer_time_previous_day" // time, viewer time, viewer timezone
+	PhraseViewerAhead           Phrase = "viewer_ahead"             // timezone, viewer timezone, duration
+	PhraseViewerBehind          Phrase = "viewer_behind"            // timezone, viewer timezone, duration
+
+	PhraseNextRun         Phrase = "next_run"          // duration, date and time
+	PhraseLastRun         Phrase = "last_run"          // duration, date and time
+	PhraseDurationSecond  Phrase = "duration_second"   // 1
+	PhraseDurationSeconds Phrase = "duration_seconds"  // count
+	PhraseDurationMinute  Phrase = "duration_minute"   // 1
+	PhraseDurationMinutes Phrase = "duration_minutes"  // count
+	PhraseDurationHour    Phrase = "duration_hour"     // 1
+	PhraseDurationHours   Phrase = "duration_hours"    // count
+	PhraseDurationDay     Phrase = "duration_day"      // 1
+	PhraseDurationDays    Phrase = "duration_days"     // count
+	PhraseDurationDaysRun Phrase = "duration_days_run" // count, inside next_run and last_run; duration_days if missing
+	PhraseDurationPair    Phrase = "duration_pair"     // larger and smaller unit
+	PhraseWeekdayTime     Phrase = "weekday_time"      // short day name, time
+	PhraseDateTime        Phrase = "date_time"         // short day name, day number, month name, time
+	PhraseDateTimeYear    Phrase = "date_time_year"    // short day name, day number, month name, year, time
+
+	PhraseLastDayOfMonth     Phrase = "last_day_of_month"     // "on the last day of the month"
+	PhraseLastWeekdayOfMonth Phrase = "last_weekday_of_month" // "on the last weekday of the month"
+	PhraseDayBeforeLastDay   Phrase = "day_before_last_day"   // "on the day before the last day of the month"
+	PhraseDaysBeforeLastDay  Phrase = "days_before_last_day"  // number of days
+	PhraseNearestWeekday     Phrase = "nearest_weekday"       // day number
+	PhraseDayOfMonth         Phrase = "day_of_month"          // day number
+	PhraseDaysOfMonthRange   Phrase = "days_of_month_range"   // first and last day numbers
+	PhraseDaysOfMonthList    Phrase = "days_of_month_list"    // list of ordinals
+	PhraseDayOfMonthOrWeek   Phrase = "day_of_month_or_week"  // day-of-month and day-of-week descriptions
+
+	PhraseInMonths   Phrase = "in_months"   // month name or names list
+	PhraseMonthRange Phrase = "month_range" // first and last month names
+
+	PhraseEveryDay         Phrase = "every_day"           // "every day", before a month step
+	PhraseEveryNDays       Phrase = "every_n_days"        // step
+	PhraseEveryNDaysFrom   Phrase = "every_n_days_from"   // step, first day number
+	PhraseEveryNMonths     Phrase = "every_n_months"      // step
+	PhraseEveryNMonthsFrom Phrase = "every_n_months_from" // step, first month name
+
+	PhraseNthWeekday    Phrase = "nth_weekday"     // ordinal, day name
+	PhraseLastWeekdayOf Phrase = "last_weekday_of" // day name
+	PhraseOnWeekdays    Phrase = "on_weekdays"     // "on weekdays"
+	PhraseOnWeekends    Phrase = "on_weekends"     // "on weekends"
+	PhraseOnDays        Phrase = "on_days"         // day name or names list
+	PhraseDayRange      Phrase = "day_range"       // first and last day names
+	PhraseValueRange    Phrase = "value_range"     // first and last values
+)
+
+// Locale holds the words, phrases and formats used to describe expressions
+// in one language
+//
+// Phrases missing from a locale fall back to English, so custom locales only
+// need to translate what they use.
+type Locale struct {
+	// Tag is the language tag the locale is registered under, e.g. "de" or
+	// "pt-BR"
+	Tag string
+
+	Months   [12]string // Month names, January first
+	Weekdays [7]string  // Day names, Sunday first
+
+	// WeekdaysShort are abbreviated day names, Sunday first; Weekdays are
+	// used if empty
+	WeekdaysShort [7]string
+
+	// Phrases maps each Phrase to its fmt template
+	Phrases map[Phrase]string
+
+	// Ordinal formats n as an ordinal number, e.g. "2nd" or "2."; nil uses
+	// the plain number
+	Ordinal func(n int) string
+
+	// DayOfMonth formats a day of the month for PhraseDaysOfMonthList, which
+	// then describes single days too, e.g. French "le 1er" and "le 15"; nil
+	// lists days with Ordinal and gives single days to PhraseDayOfMonth
+	DayOfMonth func(n int) string
+
+	// TimeFormat and TimeFormat24 are time.Format layouts for times of day in
+	// 12-hour and 24-hour mode, e.g. "3:04 PM" and "15:04"
+	TimeFormat   string
+	TimeFormat24 string
+
+	// TimeFormatSeconds and TimeFormatSeconds24 are the layouts for times of
+	// day with seconds, used by verbose descriptions, e.g. "3:04:05 PM"
+	TimeFormatSeconds   string
+	TimeFormatSeconds24 string
+
+	// HourFormat and HourFormat24 are layouts for whole hours, e.g. "3 PM"
+	HourFormat   string
+	HourFormat24 string
+
+	// AM and PM replace the "AM" and "PM" produced by the layouts, if set
+	AM, PM string
+
+	// ListSeparator joins list items, ListPair joins exactly two items and
+	// ListFinal joins the last item of a longer list: "a, b, and c"
+	ListSeparator string
+	ListPair      string
+	ListFinal     string
+
+	// PartSeparator joins the parts of a description, e.g. the time and
+	// the days
+	PartSeparator string
+}
+
+// ErrInvalidLocale is returned by RegisterLocale for a locale without a tag
+var ErrInvalidLocale = errors.New("locale must have a tag")
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]*Locale{}
+)
+
+func init() {
+	for _, l := range []*Locale{localeEN, localeDE, localeFR, localeES, localeJA} {
+		locales[strings.ToLower(l.Tag)] = l
+	}
+}
+
+// RegisterLocale makes a locale available to DescriptionOptions.Locale,
+// replacing any locale registered under the same tag
+//
+// Example:
+//
+//	expressparser.RegisterLocale(&expressparser.Locale{
+//	    Tag:      "nl",
+//	    Months:   [12]string{"januari", "februari", ...},
+//	    Weekdays: [7]string{"zondag", "maandag", ...},
+//	    Phrases:  map[expressparser.Phrase]string{expressparser.PhraseAt: "om %s", ...},
+//	})
+func RegisterLocale(l *Locale) error {
+	if l == nil || l.Tag == "" {
+		return ErrInvalidLocale
+	}
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[strings.ToLower(l.Tag)] = l
+	return nil
+}
+
+// LookupLocale returns the locale registered for tag
+//
+// Tags are matched case-insensitively, and a regional tag such as "de-AT"
+// falls back to its language ("de").
+func LookupLocale(tag string) (*Locale, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	for tag != "" {
+		if l, ok := locales[tag]; ok {
+			return l, true
+		}
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	return nil, false
+}
+
+// Locales returns the tags of all registered locales in sorted order
+func Locales() []string {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	tags := make([]string, 0, len(locales))
+	for _, l := range locales {
+		tags = append(tags, l.Tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// resolveLocale returns the locale for tag, or English if none is registered
+func resolveLocale(tag string) *Locale {
+	if l, ok := LookupLocale(tag); ok {
+		return l
+	}
+	return localeEN
+}
+
+// phrase formats a phrase template, falling back to English
+func (l *Locale) phrase(id Phrase, args ...any) string {
+	tmpl, ok := l.Phrases[id]
+	if !ok {
+		tmpl = localeEN.Phrases[id]
+	}
+	return fmt.Sprintf(tmpl, args...)
+}
+
+func (l *Locale) month(m int) string {
+	switch {
+	case m < 1 || m > 12:
+		return fmt.Sprintf("Month %d", m)
+	case l.Months[m-1] != "":
+		return l.Months[m-1]
+	}
+	return localeEN.Months[m-1]
+}
+
+func (l *Locale) weekday(d int) string {
+	switch {
+	case d < 0 || d > 6:
+		return fmt.Sprintf("Day %d", d)
+	case l.Weekdays[d] != "":
+		return l.Weekdays[d]
+	}
+	return localeEN.Weekdays[d]
+}
+
+func (l *Locale) weekdayShort(d int) string {
+	if d >= 0 && d <= 6 && l.WeekdaysShort[d] != "" {
+		return l.WeekdaysShort[d]
+	}
+	return l.weekday(d)
+}
+
+func (l *Locale) ordinal(n int) string {
+	if l.Ordinal == nil {
+		return fmt.Sprint(n)
+	}
+	return l.Ordinal(n)
+}
+
+// clock formats a time of day with layout, substituting the AM/PM markers
+func (l *Locale) clock(layout string, hour, minute, second int) string {
+	s := time.Date(2000, time.January, 1, hour, minute, second, 0, time.UTC).Format(layout)
+	if l.AM != "" {
+		s = strings.Replace(s, "AM", l.AM, 1)
+	}
+	if l.PM != "" {
+		s = strings.Replace(s, "PM", l.PM, 1)
+	}
+	return s
+}
+
+func (l *Locale) formatTime(hour, minute int, use24h bool) string {
+	layout := orDefault(l.TimeFormat, localeEN.TimeFormat)
+	if use24h {
+		layout = orDefault(l.TimeFormat24, localeEN.TimeFormat24)
+	}
+	return l.clock(layout, hour, minute, 0)
+}
+
+func (l *Locale) formatTimeSeconds(hour, minute, second int, use24h bool) string {
+	layout := orDefault(l.TimeFormatSeconds, localeEN.TimeFormatSeconds)
+	if use24h {
+		layout = orDefault(l.TimeFormatSeconds24, localeEN.TimeFormatSeconds24)
+	}
+	return l.clock(layout, hour, minute, second)
+}
+
+func (l *Locale) formatHour(hour int, use24h bool) string {
+	layout := orDefault(l.HourFormat, localeEN.HourFormat)
+	if use24h {
+		layout = orDefault(l.HourFormat24, localeEN.HourFormat24)
+	}
+	return l.clock(layout, hour, 0, 0)
+}
+
+// list joins items with the locale's separators and conjunction
+func (l *Locale) list(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + orDefault(l.ListPair, localeEN.ListPair) + items[1]
+	}
+	sep := orDefault(l.ListSeparator, localeEN.ListSeparator)
+	return strings.Join(items[:len(items)-1], sep) + orDefault(l.ListFinal, localeEN.ListFinal) + items[len(items)-1]
+}
+
+// join joins items with the list separator only
+func (l *Locale) join(items []string) string {
+	return strings.Join(items, orDefault(l.ListSeparator, localeEN.ListSeparator))
+}
+
+func (l *Locale) partSeparator() string {
+	return orDefault(l.PartSeparator, localeEN.PartSeparator)
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,531 @@
+// locale_builtin.go - Built-in description locales
+
+package expressparser
+
+import "fmt"
+
+var localeEN = &Locale{
+	Tag: "en",
+	Months: [12]string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	},
+	Weekdays: [7]string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	},
+	WeekdaysShort: [7]string{
+		"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
+	},
+	Phrases: map[Phrase]string{
+		PhraseEverySecond:          "every second",
+		PhraseSecondsOfEveryMinute: "at second %s of every minute",
+		PhraseEveryMinute:          "every minute",
+		PhraseEveryHour:            "every hour",
+		PhraseMinutePastEveryHour:  "at %d minute(s) past every hour",
+		PhraseMinutesOfEveryHour:   "at minute %s of every hour",
+		PhraseAt:                   "at %s",
+		PhraseAtWithSeconds:        "at %s and %d second(s)",
+		PhraseMinutesOfHours:       "at minute %s of %s",
+		PhraseMinutesDuringHours:   "at minute %s, during hour %s",
+		PhraseAtSeconds:            "at second %s",
+		PhraseAtMinutes:            "at minute %s",
+
+		PhraseEveryNSeconds:        "every %d seconds",
+		PhraseEveryNSecondsFrom:    "every %d seconds starting at second %d",
+		PhraseEveryNMinutes:        "every %d minutes",
+		PhraseEveryNMinutesFrom:    "every %d minutes starting at minute %d",
+		PhraseEveryNHours:          "every %d hours",
+		PhraseEveryNHoursFrom:      "every %d hours starting at %s",
+		PhraseSecondRange:          "from second %d through %d",
+		PhraseMinuteRange:          "from minute %d through %d past the hour",
+		PhraseBetween:              "between %s and %s",
+		PhraseMinuteOfHoursBetween: "at minute %d, every hour between %s and %s",
+
+		PhraseInTimezone:            "%s (%s)",
+		PhraseViewerTime:            "%s (%s %s)",
+		PhraseViewerTimeNextDay:     "%s (%s %s, next day)",
+		PhraseViewerTimePreviousDay: "%s (%s %s, previous day)",
+		PhraseViewerAhead:           "%s; %s is %s ahead",
+		PhraseViewerBehind:          "%s; %s is %s behind",
+
+		PhraseNextRun:         "next run in %s (%s)",
+		PhraseLastRun:         "last ran %s ago (%s)",
+		PhraseDurationSecond:  "%d second",
+		PhraseDurationSeconds: "%d seconds",
+		PhraseDurationMinute:  "%d minute",
+		PhraseDurationMinutes: "%d minutes",
+		PhraseDurationHour:    "%d hour",
+		PhraseDurationHours:   "%d hours",
+		PhraseDurationDay:     "%d day",
+		PhraseDurationDays:    "%d days",
+		PhraseDurationPair:    "%s %s",
+		PhraseWeekdayTime:     "%s %s",
+		PhraseDateTime:        "%[1]s %[3]s %[2]d %[4]s",
+		PhraseDateTimeYear:    "%[1]s %[3]s %[2]d, %[4]d %[5]s",
+
+		PhraseLastDayOfMonth:     "on the last day of the month",
+		PhraseLastWeekdayOfMonth: "on the last weekday of the month",
+		PhraseDayBeforeLastDay:   "on the day before the last day of the month",
+		PhraseDaysBeforeLastDay:  "on the day %d days before the last day of the month",
+		PhraseNearestWeekday:     "on the weekday nearest to day %d of the month",
+		PhraseDayOfMonth:         "on day %d of the month",
+		PhraseDaysOfMonthRange:   "on days %d through %d of the month",
+		PhraseDaysOfMonthList:    "on day %s of the month",
+		PhraseDayOfMonthOrWeek:   "%s or %s",
+
+		PhraseInMonths:   "in %s",
+		PhraseMonthRange: "from %s through %s",
+
+		PhraseEveryDay:         "every day",
+		PhraseEveryNDays:       "every %d days",
+		PhraseEveryNDaysFrom:   "every %d days starting on day %d of the month",
+		PhraseEveryNMonths:     "every %d months",
+		PhraseEveryNMonthsFrom: "every %d months starting in %s",
+
+		PhraseNthWeekday:    "on the %s %s of the month",
+		PhraseLastWeekdayOf: "on the last %s of the month",
+		PhraseOnWeekdays:    "on weekdays",
+		PhraseOnWeekends:    "on weekends",
+		PhraseOnDays:        "on %s",
+		PhraseDayRange:      "from %s through %s",
+		PhraseValueRange:    "%d through %d",
+	},
+	Ordinal:             englishOrdinal,
+	TimeFormat:          "3:04 PM",
+	TimeFormat24:        "15:04",
+	TimeFormatSeconds:   "3:04:05 PM",
+	TimeFormatSeconds24: "15:04:05",
+	HourFormat:          "3 PM",
+	HourFormat24:        "15:04",
+	ListSeparator:       ", ",
+	ListPair:            " and ",
+	ListFinal:           ", and ",
+	PartSeparator:       ", ",
+}
+
+var localeDE = &Locale{
+	Tag: "de",
+	Months: [12]string{
+		"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember",
+	},
+	Weekdays: [7]string{
+		"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
+	},
+	WeekdaysShort: [7]string{
+		"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa",
+	},
+	Phrases: map[Phrase]string{
+		PhraseEverySecond:          "jede Sekunde",
+		PhraseSecondsOfEveryMinute: "in Sekunde %s jeder Minute",
+		PhraseEveryMinute:          "jede Minute",
+		PhraseEveryHour:            "jede Stunde",
+		PhraseMinutePastEveryHour:  "%d Minute(n) nach jeder vollen Stunde",
+		PhraseMinutesOfEveryHour:   "in Minute %s jeder Stunde",
+		PhraseAt:                   "um %s",
+		PhraseAtWithSeconds:        "um %s und %d Sekunde(n)",
+		PhraseMinutesOfHours:       "in Minute %s von %s",
+		PhraseMinutesDuringHours:   "in Minute %s, während Stunde %s",
+		PhraseAtSeconds:            "in Sekunde %s",
+		PhraseAtMinutes:            "in Minute %s",
+
+		PhraseEveryNSeconds:        "alle %d Sekunden",
+		PhraseEveryNSecondsFrom:    "alle %d Sekunden ab Sekunde %d",
+		PhraseEveryNMinutes:        "alle %d Minuten",
+		PhraseEveryNMinutesFrom:    "alle %d Minuten ab Minute %d",
+		PhraseEveryNHours:          "alle %d Stunden",
+		PhraseEveryNHoursFrom:      "alle %d Stunden ab %s",
+		PhraseSecondRange:          "von Sekunde %d bis %d",
+		PhraseMinuteRange:          "von Minute %d bis %d",
+		PhraseBetween:              "zwischen %s und %s",
+		PhraseMinuteOfHoursBetween: "in Minute %d, jede Stunde zwischen %s und %s",
+
+		PhraseInTimezone:            "%s (%s)",
+		PhraseViewerTime:            "%s (%s %s)",
+		PhraseViewerTimeNextDay:     "%s (%s %s, am nächsten Tag)",
+		PhraseViewerTimePreviousDay: "%s (%s %s, am Vortag)",
+		PhraseViewerAhead:           "%s; %s liegt %s voraus",
+		PhraseViewerBehind:          "%s; %s liegt %s zurück",
+
+		PhraseNextRun:         "nächste Ausführung in %s (%s)",
+		PhraseLastRun:         "zuletzt ausgeführt vor %s (%s)",
+		PhraseDurationSecond:  "%d Sekunde",
+		PhraseDurationSeconds: "%d Sekunden",
+		PhraseDurationMinute:  "%d Minute",
+		PhraseDurationMinutes: "%d Minuten",
+		PhraseDurationHour:    "%d Stunde",
+		PhraseDurationHours:   "%d Stunden",
+		PhraseDurationDay:     "%d Tag",
+		PhraseDurationDays:    "%d Tage",
+		PhraseDurationDaysRun: "%d Tagen",
+		PhraseDurationPair:    "%s %s",
+		PhraseWeekdayTime:     "%s %s",
+		PhraseDateTime:        "%[1]s %[2]d. %[3]s %[4]s",
+		PhraseDateTimeYear:    "%[1]s %[2]d. %[3]s %[4]d %[5]s",
+
+		PhraseLastDayOfMonth:     "am letzten Tag des Monats",
+		PhraseLastWeekdayOfMonth: "am letzten Werktag des Monats",
+		PhraseDayBeforeLastDay:   "am vorletzten Tag des Monats",
+		PhraseDaysBeforeLastDay:  "%d Tage vor dem letzten Tag des Monats",
+		PhraseNearestWeekday:     "am nächstgelegenen Werktag zum %d. des Monats",
+		PhraseDayOfMonth:         "am %d. Tag des Monats",
+		PhraseDaysOfMonthRange:   "vom %d. bis %d. Tag des Monats",
+		PhraseDaysOfMonthList:    "am %s Tag des Monats",
+		PhraseDayOfMonthOrWeek:   "%s oder %s",
+
+		PhraseInMonths:   "im %s",
+		PhraseMonthRange: "von %s bis %s",
+
+		PhraseEveryDay:         "jeden Tag",
+		PhraseEveryNDays:       "alle %d Tage",
+		PhraseEveryNDaysFrom:   "alle %d Tage ab dem %d. des Monats",
+		PhraseEveryNMonths:     "alle %d Monate",
+		PhraseEveryNMonthsFrom: "alle %d Monate ab %s",
+
+		PhraseNthWeekday:    "am %s %s des Monats",
+		PhraseLastWeekdayOf: "am letzten %s des Monats",
+		PhraseOnWeekdays:    "an Werktagen",
+		PhraseOnWeekends:    "am Wochenende",
+		PhraseOnDays:        "am %s",
+		PhraseDayRange:      "von %s bis %s",
+		PhraseValueRange:    "%d bis %d",
+	},
+	Ordinal:             func(n int) string { return fmt.Sprintf("%d.", n) },
+	TimeFormat:          "15:04",
+	TimeFormat24:        "15:04",
+	TimeFormatSeconds:   "15:04:05",
+	TimeFormatSeconds24: "15:04:05",
+	HourFormat:          "15 Uhr",
+	HourFormat24:        "15 Uhr",
+	ListSeparator:       ", ",
+	ListPair:            " und ",
+	ListFinal:           " und ",
+	PartSeparator:       ", ",
+}
+
+var localeFR = &Locale{
+	Tag: "fr",
+	Months: [12]string{
+		"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+	},
+	Weekdays: [7]string{
+		"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi",
+	},
+	WeekdaysShort: [7]string{
+		"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam.",
+	},
+	Phrases: map[Phrase]string{
+		PhraseEverySecond:          "chaque seconde",
+		PhraseSecondsOfEveryMinute: "à la seconde %s de chaque minute",
+		PhraseEveryMinute:          "chaque minute",
+		PhraseEveryHour:            "toutes les heures",
+		PhraseMinutePastEveryHour:  "à %d minute(s) après chaque heure",
+		PhraseMinutesOfEveryHour:   "à la minute %s de chaque heure",
+		PhraseAt:                   "à %s",
+		PhraseAtWithSeconds:        "à %s et %d seconde(s)",
+		PhraseMinutesOfHours:       "à la minute %s de %s",
+		PhraseMinutesDuringHours:   "à la minute %s, pendant l'heure %s",
+		PhraseAtSeconds:            "à la seconde %s",
+		PhraseAtMinutes:            "à la minute %s",
+
+		PhraseEveryNSeconds:        "toutes les %d secondes",
+		PhraseEveryNSecondsFrom:    "toutes les %d secondes à partir de la seconde %d",
+		PhraseEveryNMinutes:        "toutes les %d minutes",
+		PhraseEveryNMinutesFrom:    "toutes les %d minutes à partir de la minute %d",
+		PhraseEveryNHours:          "toutes les %d heures",
+		PhraseEveryNHoursFrom:      "toutes les %d heures à partir de %s",
+		PhraseSecondRange:          "de la seconde %d à %d",
+		PhraseMinuteRange:          "de la minute %d à %d",
+		PhraseBetween:              "entre %s et %s",
+		PhraseMinuteOfHoursBetween: "à la minute %d, toutes les heures entre %s et %s",
+
+		PhraseInTimezone:            "%s (%s)",
+		PhraseViewerTime:            "%s (%s %s)",
+		PhraseViewerTimeNextDay:     "%s (%s %s, le lendemain)",
+		PhraseViewerTimePreviousDay: "%s (%s %s, la veille)",
+		PhraseViewerAhead:           "%s ; %s a %s d'avance",
+		PhraseViewerBehind:          "%s ; %s a %s de retard",
+
+		PhraseNextRun:         "prochaine exécution dans %s (%s)",
+		PhraseLastRun:         "dernière exécution il y a %s (%s)",
+		PhraseDurationSecond:  "%d seconde",
+		PhraseDurationSeconds: "%d secondes",
+		PhraseDurationMinute:  "%d minute",
+		PhraseDurationMinutes: "%d minutes",
+		PhraseDurationHour:    "%d heure",
+		PhraseDurationHours:   "%d heures",
+		PhraseDurationDay:     "%d jour",
+		PhraseDurationDays:    "%d jours",
+		PhraseDurationPair:    "%s %s",
+		PhraseWeekdayTime:     "%s %s",
+		PhraseDateTime:        "%[1]s %[2]d %[3]s %[4]s",
+		PhraseDateTimeYear:    "%[1]s %[2]d %[3]s %[4]d %[5]s",
+
+		PhraseLastDayOfMonth:     "le dernier jour du mois",
+		PhraseLastWeekdayOfMonth: "le dernier jour ouvré du mois",
+		PhraseDayBeforeLastDay:   "l'avant-dernier jour du mois",
+		PhraseDaysBeforeLastDay:  "%d jours avant le dernier jour du mois",
+		PhraseNearestWeekday:     "le jour ouvré le plus proche du %d du mois",
+		PhraseDayOfMonth:         "le %d du mois",
+		PhraseDaysOfMonthRange:   "du %d au %d du mois",
+		PhraseDaysOfMonthList:    "%s du mois",
+		PhraseDayOfMonthOrWeek:   "%s ou %s",
+
+		PhraseInMonths:   "en %s",
+		PhraseMonthRange: "de %s à %s",
+
+		PhraseEveryDay:         "tous les jours",
+		PhraseEveryNDays:       "tous les %d jours",
+		PhraseEveryNDaysFrom:   "tous les %d jours à partir du %d du mois",
+		PhraseEveryNMonths:     "tous les %d mois",
+		PhraseEveryNMonthsFrom: "tous les %d mois à partir de %s",
+
+		PhraseNthWeekday:    "le %s %s du mois",
+		PhraseLastWeekdayOf: "le dernier %s du mois",
+		PhraseOnWeekdays:    "en semaine",
+		PhraseOnWeekends:    "le week-end",
+		PhraseOnDays:        "le %s",
+		PhraseDayRange:      "du %s au %s",
+		PhraseValueRange:    "%d à %d",
+	},
+	Ordinal: func(n int) string {
+		if n == 1 {
+			return "1er"
+		}
+		return fmt.Sprintf("%de", n)
+	},
+	DayOfMonth: func(n int) string {
+		if n == 1 {
+			return "le 1er"
+		}
+		return fmt.Sprintf("le %d", n)
+	},
+	TimeFormat:          "15:04",
+	TimeFormat24:        "15:04",
+	TimeFormatSeconds:   "15:04:05",
+	TimeFormatSeconds24: "15:04:05",
+	HourFormat:          "15 h",
+	HourFormat24:        "15 h",
+	ListSeparator:       ", ",
+	ListPair:            " et ",
+	ListFinal:           " et ",
+	PartSeparator:       ", ",
+}
+
+var localeES = &Locale{
+	Tag: "es",
+	Months: [12]string{
+		"enero", "febrero", "marzo", "abril", "mayo", "junio",
+		"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+	},
+	Weekdays: [7]string{
+		"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado",
+	},
+	WeekdaysShort: [7]string{
+		"dom", "lun", "mar", "mié", "jue", "vie", "sáb",
+	},
+	Phrases: map[Phrase]string{
+		PhraseEverySecond:          "cada segundo",
+		PhraseSecondsOfEveryMinute: "en el segundo %s de cada minuto",
+		PhraseEveryMinute:          "cada minuto",
+		PhraseEveryHour:            "cada hora",
+		PhraseMinutePastEveryHour:  "a los %d minuto(s) de cada hora",
+		PhraseMinutesOfEveryHour:   "en el minuto %s de cada hora",
+		PhraseAt:                   "a las %s",
+		PhraseAtWithSeconds:        "a las %s y %d segundo(s)",
+		PhraseMinutesOfHours:       "en el minuto %s de %s",
+		PhraseMinutesDuringHours:   "en el minuto %s, durante la hora %s",
+		PhraseAtSeconds:            "en el segundo %s",
+		PhraseAtMinutes:            "en el minuto %s",
+
+		PhraseEveryNSeconds:        "cada %d segundos",
+		PhraseEveryNSecondsFrom:    "cada %d segundos a partir del segundo %d",
+		PhraseEveryNMinutes:        "cada %d minutos",
+		PhraseEveryNMinutesFrom:    "cada %d minutos a partir del minuto %d",
+		PhraseEveryNHours:          "cada %d horas",
+		PhraseEveryNHoursFrom:      "cada %d horas a partir de las %s",
+		PhraseSecondRange:          "del segundo %d al %d",
+		PhraseMinuteRange:          "del minuto %d al %d",
+		PhraseBetween:              "entre las %s y las %s",
+		PhraseMinuteOfHoursBetween: "en el minuto %d, cada hora entre las %s y las %s",
+
+		PhraseInTimezone:            "%s (%s)",
+		PhraseViewerTime:            "%s (%s %s)",
+		PhraseViewerTimeNextDay:     "%s (%s %s, del día siguiente)",
+		PhraseViewerTimePreviousDay: "%s (%s %s, del día anterior)",
+		PhraseViewerAhead:           "%s; %s va %s por delante",
+		PhraseViewerBehind:          "%s; %s va %s por detrás",
+
+		PhraseNextRun:         "próxima ejecución en %s (%s)",
+		PhraseLastRun:         "última ejecución hace %s (%s)",
+		PhraseDurationSecond:  "%d segundo",
+		PhraseDurationSeconds: "%d segundos",
+		PhraseDurationMinute:  "%d minuto",
+		PhraseDurationMinutes: "%d minutos",
+		PhraseDurationHour:    "%d hora",
+		PhraseDurationHours:   "%d horas",
+		PhraseDurationDay:     "%d día",
+		PhraseDurationDays:    "%d días",
+		PhraseDurationPair:    "%s %s",
+		PhraseWeekdayTime:     "%s %s",
+		PhraseDateTime:        "%[1]s %[2]d de %[3]s %[4]s",
+		PhraseDateTimeYear:    "%[1]s %[2]d de %[3]s de %[4]d %[5]s",
+
+		PhraseLastDayOfMonth:     "el último día del mes",
+		PhraseLastWeekdayOfMonth: "el último día laborable del mes",
+		PhraseDayBeforeLastDay:   "el penúltimo día del mes",
+		PhraseDaysBeforeLastDay:  "%d días antes del último día del mes",
+		PhraseNearestWeekday:     "el día laborable más cercano al día %d del mes",
+		PhraseDayOfMonth:         "el día %d del mes",
+		PhraseDaysOfMonthRange:   "del día %d al %d del mes",
+		PhraseDaysOfMonthList:    "el día %s del mes",
+		PhraseDayOfMonthOrWeek:   "%s o %s",
+
+		PhraseInMonths:   "en %s",
+		PhraseMonthRange: "de %s a %s",
+
+		PhraseEveryDay:         "todos los días",
+		PhraseEveryNDays:       "cada %d días",
+		PhraseEveryNDaysFrom:   "cada %d días a partir del día %d del mes",
+		PhraseEveryNMonths:     "cada %d meses",
+		PhraseEveryNMonthsFrom: "cada %d meses a partir de %s",
+
+		PhraseNthWeekday:    "el %s %s del mes",
+		PhraseLastWeekdayOf: "el último %s del mes",
+		PhraseOnWeekdays:    "de lunes a viernes",
+		PhraseOnWeekends:    "los fines de semana",
+		PhraseOnDays:        "el %s",
+		PhraseDayRange:      "de %s a %s",
+		PhraseValueRange:    "%d a %d",
+	},
+	Ordinal:             func(n int) string { return fmt.Sprintf("%d.º", n) },
+	TimeFormat:          "15:04",
+	TimeFormat24:        "15:04",
+	TimeFormatSeconds:   "15:04:05",
+	TimeFormatSeconds24: "15:04:05",
+	HourFormat:          "15:04",
+	HourFormat24:        "15:04",
+	ListSeparator:       ", ",
+	ListPair:            " y ",
+	ListFinal:           " y ",
+	PartSeparator:       ", ",
+}
+
+var localeJA = &Locale{
+	Tag: "ja",
+	Months: [12]string{
+		"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月",
+	},
+	Weekdays: [7]string{
+		"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日",
+	},
+	WeekdaysShort: [7]string{
+		"日", "月", "火", "水", "木", "金", "土",
+	},
+	Phrases: map[Phrase]string{
+		PhraseEverySecond:          "毎秒",
+		PhraseSecondsOfEveryMinute: "毎分%s秒",
+		PhraseEveryMinute:          "毎分",
+		PhraseEveryHour:            "毎時0分",
+		PhraseMinutePastEveryHour:  "毎時%d分",
+		PhraseMinutesOfEveryHour:   "毎時%s分",
+		PhraseAt:                   "%s",
+		PhraseAtWithSeconds:        "%s %d秒",
+		PhraseMinutesOfHours:       "%[2]sの%[1]s分",
+		PhraseMinutesDuringHours:   "%[2]s時台の%[1]s分",
+		PhraseAtSeconds:            "%s秒",
+		PhraseAtMinutes:            "%s分",
+
+		PhraseEveryNSeconds:        "%d秒ごと",
+		PhraseEveryNSecondsFrom:    "%[2]d秒から%[1]d秒ごと",
+		PhraseEveryNMinutes:        "%d分ごと",
+		PhraseEveryNMinutesFrom:    "%[2]d分から%[1]d分ごと",
+		PhraseEveryNHours:          "%d時間ごと",
+		PhraseEveryNHoursFrom:      "%[2]sから%[1]d時間ごと",
+		PhraseSecondRange:          "%d秒から%d秒まで",
+		PhraseMinuteRange:          "%d分から%d分まで",
+		PhraseBetween:              "%sから%sまで",
+		PhraseMinuteOfHoursBetween: "%[2]sから%[3]sまで毎時%[1]d分",
+
+		PhraseInTimezone:            "%s（%s）",
+		PhraseViewerTime:            "%s（%[3]s %[2]s）",
+		PhraseViewerTimeNextDay:     "%s（%[3]s 翌日%[2]s）",
+		PhraseViewerTimePreviousDay: "%s（%[3]s 前日%[2]s）",
+		PhraseViewerAhead:           "%s、%sは%s進んでいます",
+		PhraseViewerBehind:          "%s、%sは%s遅れています",
+
+		PhraseNextRun:         "次回実行まで%s（%s）",
+		PhraseLastRun:         "前回実行は%s前（%s）",
+		PhraseDurationSecond:  "%d秒",
+		PhraseDurationSeconds: "%d秒",
+		PhraseDurationMinute:  "%d分",
+		PhraseDurationMinutes: "%d分",
+		PhraseDurationHour:    "%d時間",
+		PhraseDurationHours:   "%d時間",
+		PhraseDurationDay:     "%d日",
+		PhraseDurationDays:    "%d日",
+		PhraseDurationPair:    "%s%s",
+		PhraseWeekdayTime:     "(%s) %s",
+		PhraseDateTime:        "%[3]s%[2]d日(%[1]s) %[4]s",
+		PhraseDateTimeYear:    "%[4]d年%[3]s%[2]d日(%[1]s) %[5]s",
+
+		PhraseLastDayOfMonth:     "毎月末日",
+		PhraseLastWeekdayOfMonth: "毎月最終平日",
+		PhraseDayBeforeLastDay:   "毎月末日の前日",
+		PhraseDaysBeforeLastDay:  "毎月末日の%d日前",
+		PhraseNearestWeekday:     "毎月%d日に最も近い平日",
+		PhraseDayOfMonth:         "毎月%d日",
+		PhraseDaysOfMonthRange:   "毎月%d日から%d日まで",
+		PhraseDaysOfMonthList:    "毎月%s日",
+		PhraseDayOfMonthOrWeek:   "%sまたは%s",
+
+		PhraseInMonths:   "%s",
+		PhraseMonthRange: "%sから%sまで",
+
+		PhraseEveryDay:         "毎日",
+		PhraseEveryNDays:       "%d日ごと",
+		PhraseEveryNDaysFrom:   "毎月%[2]d日から%[1]d日ごと",
+		PhraseEveryNMonths:     "%dか月ごと",
+		PhraseEveryNMonthsFrom: "%[2]sから%[1]dか月ごと",
+
+		PhraseNthWeekday:    "第%s%s",
+		PhraseLastWeekdayOf: "最終%s",
+		PhraseOnWeekdays:    "平日",
+		PhraseOnWeekends:    "週末",
+		PhraseOnDays:        "%s",
+		PhraseDayRange:      "%sから%sまで",
+		PhraseValueRange:    "%dから%d",
+	},
+	TimeFormat:          "15:04",
+	TimeFormat24:        "15:04",
+	TimeFormatSeconds:   "15:04:05",
+	TimeFormatSeconds24: "15:04:05",
+	HourFormat:          "15時",
+	HourFormat24:        "15時",
+	ListSeparator:       "、",
+	ListPair:            "と",
+	ListFinal:           "、",
+	PartSeparator:       "、",
+}
+
+// englishOrdinal formats n as "1st", "2nd", "3rd", "4th", ...
+func englishOrdinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		if n%100 != 11 {
+			suffix = "st"
+		}
+	case 2:
+		if n%100 != 12 {
+			suffix = "nd"
+		}
+	case 3:
+		if n%100 != 13 {
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,140 @@
+// locale_test.go - Tests for localized descriptions
+
+package expressparser
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDescribe_BuiltinLocales(t *testing.T) {
+	tests := []struct {
+		locale string
+		expr   string
+		want   string
+	}{
+		{"en", "0 9 * * 1-5", "At 9:00 AM, on weekdays"},
+		{"de", "0 9 * * 1-5", "Um 09:00, an Werktagen"},
+		{"de", "30 14 1,15 * *", "Um 14:30, am 1. und 15. Tag des Monats"},
+		{"de", "0 9 * * 1#2", "Um 09:00, am 2. Montag des Monats"},
+		{"de", "0 0 L 1-3 *", "Um 00:00, am letzten Tag des Monats, von Januar bis März"},
+		{"de", "0 0 1 * 1", "Um 00:00, am 1. Tag des Monats oder am Montag"},
+		{"fr", "0 9 * * 1-5", "À 09:00, en semaine"},
+		{"fr", "*/15 9-17 * * *", "Toutes les 15 minutes, entre 09:00 et 17:59"},
+		{"fr", "30 14 1,15 * *", "À 14:30, le 1er et le 15 du mois"},
+		{"fr", "0 0 * 6 5L", "À 00:00, en juin, le dernier vendredi du mois"},
+		{"es", "0 9 * * 1#2", "A las 09:00, el 2.º lunes del mes"},
+		{"es", "0 9,17 * * SAT,SUN", "A las 09:00, 17:00, los fines de semana"},
+		{"ja", "0 9 * * 1-5", "09:00、平日"},
+		{"ja", "30 14 1,15 * *", "14:30、毎月1と15日"},
+		{"ja", "0 9 * * 1#2", "09:00、第2月曜日"},
+		{"ja", "5 * * * *", "毎時5分"},
+		{"ja", "0 1/2 * * *", "01:00から2時間ごと"},
+		{"ja", "0 9-17 * * *", "09:00から17:00まで毎時0分"},
+		{"de", "30 9-17 * * *", "In Minute 30, jede Stunde zwischen 09:30 und 17:30"},
+		{"ja", "30 0 9 * * *", "09:00 30秒"},
+		{"ja", "30 */5 9-17 * * *", "30秒、5分ごと、09:00から17:59まで"},
+		{"ja", "0 0 L-1 * *", "00:00、毎月末日の前日"},
+		{"en", "0 0 L-1 * *", "At 12:00 AM, on the day before the last day of the month"},
+		{"de", "0 0 L-1,L-3 * *", "Um 00:00, am vorletzten Tag des Monats und 3 Tage vor dem letzten Tag des Monats"},
+		{"es", "0 0 1 2/3 *", "A las 00:00, el día 1 del mes, cada 3 meses a partir de febrero"},
+		{"de", "0 0 * */3 *", "Um 00:00, jeden Tag, alle 3 Monate"},
+		{"fr", "0 0 1 * *", "À 00:00, le 1er du mois"},
+		{"fr", "0 0 1,10,20 * *", "À 00:00, le 1er, le 10 et le 20 du mois"},
+		{"ja", "30 */5 * * * *", "30秒、5分ごと"},
+		{"ja", "0,15,30,45 9,10,11,12 * * *", "15分ごと、09:00から12:59まで"},
+		{"ja", "0,30 */2 * * *", "0と30分、2時間ごと"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.expr, func(t *testing.T) {
+			got := DescribeWithOptions(MustParse(tt.expr), DescriptionOptions{Locale: tt.locale})
+			if got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDescribe_BuiltinLocalesVerboseLists(t *testing.T) {
+	tests := map[string]string{
+		"en": "At 12:00 AM, in January and June, on Monday, Wednesday, and Friday",
+		"de": "Um 00:00, im Januar und Juni, am Montag, Mittwoch und Freitag",
+		"fr": "À 00:00, en janvier et juin, le lundi, mercredi et vendredi",
+		"es": "A las 00:00, en enero y junio, el lunes, miércoles y viernes",
+	}
+
+	expr := MustParse("0 0 * 1,6 1,3,5")
+	for locale, want := range tests {
+		got := DescribeWithOptions(expr, DescriptionOptions{Locale: locale, Verbose: true})
+		if got != want {
+			t.Errorf("%s: Describe() = %q, want %q", locale, got, want)
+		}
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+		ok   bool
+	}{
+		{"de", "de", true},
+		{"DE", "de", true},
+		{"de-AT", "de", true},
+		{"fr_CA", "fr", true},
+		{"xx", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		l, ok := LookupLocale(tt.tag)
+		if ok != tt.ok || (ok && l.Tag != tt.want) {
+			t.Errorf("LookupLocale(%q) = %v, %v, want %q, %v", tt.tag, l, ok, tt.want, tt.ok)
+		}
+	}
+
+	// Unknown locales describe in English
+	got := DescribeWithOptions(MustParse("0 9 * * 1-5"), DescriptionOptions{Locale: "xx"})
+	if got != "At 9:00 AM, on weekdays" {
+		t.Errorf("Describe() with unknown locale = %q", got)
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	if err := RegisterLocale(&Locale{}); !errors.Is(err, ErrInvalidLocale) {
+		t.Errorf("RegisterLocale(no tag) = %v, want ErrInvalidLocale", err)
+	}
+
+	err := RegisterLocale(&Locale{
+		Tag:      "x-test",
+		Weekdays: [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		Phrases: map[Phrase]string{
+			PhraseAt:     "om %s",
+			PhraseOnDays: "op %s",
+		},
+		TimeFormat: "3:04 PM",
+		AM:         "vm",
+		PM:         "nm",
+		ListPair:   " en ",
+	})
+	if err != nil {
+		t.Fatalf("RegisterLocale() = %v", err)
+	}
+
+	opts := DescriptionOptions{Locale: "x-test"}
+	if got := DescribeWithOptions(MustParse("0 9 * * 1"), opts); got != "Om 9:00 vm, op maandag" {
+		t.Errorf("Describe() = %q", got)
+	}
+	if got := DescribeWithOptions(MustParse("0 21 1,15 * *"), opts); got != "Om 9:00 nm, on day 1 en 15 of the month" {
+		t.Errorf("Describe() with missing phrases = %q", got)
+	}
+
+	found := false
+	for _, tag := range Locales() {
+		found = found || tag == "x-test"
+	}
+	if !found {
+		t.Errorf("Locales() = %v, missing x-test", Locales())
+	}
+}
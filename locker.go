@@ -0,0 +1,173 @@
+// locker.go - Single-execution locks for runners shared across replicas
+
+package expressparser
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Locker decides which replica executes a given occurrence of a job
+//
+// A Runner calls TryLock before running each occurrence. The occurrence is
+// the nominal time returned by Scheduler.Next, not the wall-clock time the
+// runner woke up at, so every replica computes the same key for the same run
+// regardless of timer jitter.
+//
+// If ok is false the occurrence is skipped. Otherwise release is called once
+// the job has returned.
+type Locker interface {
+	TryLock(jobID string, occurrence time.Time) (release func(), ok bool)
+}
+
+// MemoryLocker is an in-process Locker, mainly useful in tests
+//
+// Each job may be claimed at most once per occurrence. Claims are kept after
+// release so that a runner reaching the same occurrence late still skips it.
+type MemoryLocker struct {
+	mu      sync.Mutex
+	claimed map[string]time.Time
+}
+
+// NewMemoryLocker creates an empty MemoryLocker
+func NewMemoryLocker() *MemoryLocker {
+	return &MemoryLocker{claimed: make(map[string]time.Time)}
+}
+
+// TryLock claims the occurrence if no later or equal occurrence of the job
+// has been claimed yet
+func (l *MemoryLocker) TryLock(jobID string, occurrence time.Time) (func(), bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if last, ok := l.claimed[jobID]; ok && !occurrence.After(last) {
+		return nil, false
+	}
+	l.claimed[jobID] = occurrence
+	return func() {}, true
+}
+
+// FileLocker is a Locker backed by lock files in a shared directory
+//
+// It works for replicas on the same host or on a filesystem that honours
+// exclusive file creation (O_EXCL). Each occurrence is claimed by creating
+// "<job>.<unix-seconds>.lock". As with MemoryLocker, an occurrence is only
+// claimed if no later or equal occurrence of the job has been, so a replica
+// whose clock lags cannot rerun an occurrence whose lock file was removed.
+// The newest lock file of each job is kept; earlier ones are removed when a
+// newer occurrence is claimed.
+type FileLocker struct {
+	dir string
+}
+
+// NewFileLocker creates a FileLocker storing its lock files in dir
+//
+// The directory is created if it does not exist.
+func NewFileLocker(dir string) (*FileLocker, error) {
+	if dir == "" {
+		return nil, errors.New("lock directory cannot be empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create lock directory: %w", err)
+	}
+	return &FileLocker{dir: dir}, nil
+}
+
+// Dir returns the directory holding the lock files
+func (l *FileLocker) Dir() string {
+	return l.dir
+}
+
+// TryLock claims the occurrence by exclusively creating its lock file,
+// provided no later occurrence of the job has been claimed
+//
+// Any error other than the file already existing is treated as a failed
+// claim, so a broken lock directory never results in duplicate runs.
+func (l *FileLocker) TryLock(jobID string, occurrence time.Time) (func(), bool) {
+	prefix := lockFileName(jobID)
+	unix := occurrence.Unix()
+	if newest, ok := l.newest(prefix); ok && newest >= unix {
+		return nil, false
+	}
+
+	path := filepath.Join(l.dir, prefix+"."+strconv.FormatInt(unix, 10)+".lock")
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, false
+	}
+	host, _ := os.Hostname()
+	fmt.Fprintf(f, "%s %d\n", host, os.Getpid())
+	f.Close()
+
+	// Another replica may have claimed a later occurrence, and pruned this
+	// one's earlier lock file, between the check above and the create
+	if newest, ok := l.newest(prefix); !ok || newest != unix {
+		os.Remove(path)
+		return nil, false
+	}
+
+	l.prune(prefix, unix)
+	return func() {}, true
+}
+
+// stamps returns the occurrence of each lock file of one job, keyed by path
+func (l *FileLocker) stamps(prefix string) map[string]int64 {
+	matches, err := filepath.Glob(filepath.Join(l.dir, prefix+".*.lock"))
+	if err != nil {
+		return nil
+	}
+	stamps := make(map[string]int64, len(matches))
+	for _, m := range matches {
+		stamp := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(m), prefix+"."), ".lock")
+		if v, err := strconv.ParseInt(stamp, 10, 64); err == nil {
+			stamps[m] = v
+		}
+	}
+	return stamps
+}
+
+// newest returns the latest claimed occurrence of one job
+func (l *FileLocker) newest(prefix string) (int64, bool) {
+	var newest int64
+	found := false
+	for _, v := range l.stamps(prefix) {
+		if !found || v > newest {
+			newest, found = v, true
+		}
+	}
+	return newest, found
+}
+
+// prune removes lock files of occurrences earlier than unix for one job
+func (l *FileLocker) prune(prefix string, unix int64) {
+	for path, v := range l.stamps(prefix) {
+		if v < unix {
+			os.Remove(path)
+		}
+	}
+}
+
+// lockFileName maps a job ID to a string that is safe to use as a file name,
+// escaping every other byte as %XX so distinct IDs never share a lock file
+func lockFileName(jobID string) string {
+	if jobID == "" {
+		return "%"
+	}
+	var b strings.Builder
+	for i := 0; i < len(jobID); i++ {
+		c := jobID[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
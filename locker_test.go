@@ -0,0 +1,115 @@
+package expressparser
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMemoryLocker_SingleClaimPerOccurrence(t *testing.T) {
+	l := NewMemoryLocker()
+	occurrence := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	acquired := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if release, ok := l.TryLock("backup", occurrence); ok {
+				mu.Lock()
+				acquired++
+				mu.Unlock()
+				release()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if acquired != 1 {
+		t.Errorf("acquired %d times, want 1", acquired)
+	}
+
+	// A late replica must not re-run the released occurrence
+	if _, ok := l.TryLock("backup", occurrence); ok {
+		t.Errorf("TryLock() succeeded for an already claimed occurrence")
+	}
+
+	// The next occurrence and other jobs are independent
+	if _, ok := l.TryLock("backup", occurrence.Add(time.Hour)); !ok {
+		t.Errorf("TryLock() failed for the next occurrence")
+	}
+	if _, ok := l.TryLock("report", occurrence); !ok {
+		t.Errorf("TryLock() failed for a different job")
+	}
+}
+
+func TestFileLocker_TryLock(t *testing.T) {
+	dir := t.TempDir()
+	a, err := NewFileLocker(dir)
+	if err != nil {
+		t.Fatalf("NewFileLocker() error = %v", err)
+	}
+	b, err := NewFileLocker(dir)
+	if err != nil {
+		t.Fatalf("NewFileLocker() error = %v", err)
+	}
+
+	first := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	release, ok := a.TryLock("nightly/backup", first)
+	if !ok {
+		t.Fatalf("TryLock() on first replica failed")
+	}
+	if _, ok := b.TryLock("nightly/backup", first); ok {
+		t.Errorf("TryLock() on second replica succeeded for the same occurrence")
+	}
+	release()
+	if _, ok := b.TryLock("nightly/backup", first); ok {
+		t.Errorf("TryLock() succeeded after release for the same occurrence")
+	}
+
+	second := first.Add(24 * time.Hour)
+	if _, ok := b.TryLock("nightly/backup", second); !ok {
+		t.Fatalf("TryLock() failed for the next occurrence")
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.lock"))
+	if len(files) != 1 {
+		t.Errorf("lock files = %v, want only the latest occurrence", files)
+	}
+
+	// A replica whose clock lags must not rerun the pruned first occurrence
+	if _, ok := a.TryLock("nightly/backup", first); ok {
+		t.Errorf("TryLock() succeeded for an occurrence older than the latest claim")
+	}
+	files, _ = filepath.Glob(filepath.Join(dir, "*.lock"))
+	if len(files) != 1 {
+		t.Errorf("lock files = %v after a refused claim, want only the latest occurrence", files)
+	}
+}
+
+func TestFileLocker_DistinctJobIDs(t *testing.T) {
+	l, err := NewFileLocker(filepath.Join(t.TempDir(), "locks"))
+	if err != nil {
+		t.Fatalf("NewFileLocker() error = %v", err)
+	}
+	if _, err := os.Stat(l.Dir()); err != nil {
+		t.Fatalf("lock directory not created: %v", err)
+	}
+
+	occurrence := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, id := range []string{"a.b", "a_b", "a/b", "a"} {
+		if _, ok := l.TryLock(id, occurrence); !ok {
+			t.Errorf("TryLock(%q) failed, job IDs must not share lock files", id)
+		}
+	}
+}
+
+func TestNewFileLocker_EmptyDir(t *testing.T) {
+	if _, err := NewFileLocker(""); err == nil {
+		t.Errorf("NewFileLocker(\"\") error = nil, want error")
+	}
+}
@@ -0,0 +1,275 @@
+// metrics.go - Prometheus text-format metrics for scheduled jobs
+
+package expressparser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultMetricsBuckets are the histogram bucket upper bounds, in seconds,
+// used for run durations and schedule lag unless overridden
+var DefaultMetricsBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300}
+
+// Metrics collects per-job metrics from runner events and renders them in
+// the Prometheus text exposition format
+//
+// Metrics implements EventListener, so it can be passed to WithEventListener,
+// and http.Handler, so it can be mounted on a /metrics endpoint. The
+// following series are exported, all labelled by job:
+//
+//	<prefix>_job_runs_total                   counter of completed runs
+//	<prefix>_job_failures_total               counter of failed runs
+//	<prefix>_job_skips_total                  counter of skipped occurrences, by reason
+//	<prefix>_job_duration_seconds             histogram of run durations
+//	<prefix>_job_lag_seconds                  histogram of actual start minus nominal time
+//	<prefix>_job_next_run_timestamp_seconds   gauge of the next nominal run time
+type Metrics struct {
+	mu              sync.Mutex
+	prefix          string
+	durationBuckets []float64
+	lagBuckets      []float64
+	jobs            map[string]*jobMetrics
+}
+
+// jobMetrics holds the series of a single job
+type jobMetrics struct {
+	runs     uint64
+	failures uint64
+	skips    map[string]uint64
+	duration *histogram
+	lag      *histogram
+	nextRun  float64
+}
+
+// histogram is a Prometheus histogram with cumulative bucket counts
+type histogram struct {
+	bounds []float64
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{bounds: bounds, counts: make([]uint64, len(bounds))}
+}
+
+func (h *histogram) observe(v float64) {
+	for i, b := range h.bounds {
+		if v <= b {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// MetricsOption configures Metrics
+type MetricsOption func(*Metrics)
+
+// WithMetricsPrefix sets the metric name prefix (default "cron")
+func WithMetricsPrefix(prefix string) MetricsOption {
+	return func(m *Metrics) {
+		m.prefix = prefix
+	}
+}
+
+// WithDurationBuckets sets the histogram buckets for run durations, in seconds
+func WithDurationBuckets(buckets ...float64) MetricsOption {
+	return func(m *Metrics) {
+		m.durationBuckets = sortedBuckets(buckets)
+	}
+}
+
+// WithLagBuckets sets the histogram buckets for schedule lag, in seconds
+func WithLagBuckets(buckets ...float64) MetricsOption {
+	return func(m *Metrics) {
+		m.lagBuckets = sortedBuckets(buckets)
+	}
+}
+
+func sortedBuckets(buckets []float64) []float64 {
+	b := append([]float64(nil), buckets...)
+	sort.Float64s(b)
+	return b
+}
+
+// NewMetrics creates an empty metrics collector
+func NewMetrics(opts ...MetricsOption) *Metrics {
+	m := &Metrics{
+		prefix:          "cron",
+		durationBuckets: DefaultMetricsBuckets,
+		lagBuckets:      DefaultMetricsBuckets,
+		jobs:            make(map[string]*jobMetrics),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// job returns the series of a job, creating them on first use
+func (m *Metrics) job(id string) *jobMetrics {
+	j, ok := m.jobs[id]
+	if !ok {
+		j = &jobMetrics{
+			skips:    make(map[string]uint64),
+			duration: newHistogram(m.durationBuckets),
+			lag:      newHistogram(m.lagBuckets),
+		}
+		m.jobs[id] = j
+	}
+	return j
+}
+
+// OnEvent updates the metrics of the event's job
+func (m *Metrics) OnEvent(e Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	j := m.job(e.JobID)
+	switch e.Type {
+	case EventScheduled:
+		j.nextRun = float64(e.Scheduled.UnixNano()) / 1e9
+	case EventStarted:
+		j.lag.observe(e.Lag().Seconds())
+	case EventFinished, EventFailed:
+		j.runs++
+		if e.Type == EventFailed {
+			j.failures++
+		}
+		j.duration.observe(e.Duration.Seconds())
+	case EventSkipped:
+		j.skips[e.Reason] += uint64(max(e.Missed, 1))
+	case EventNoNextRun:
+		j.nextRun = 0
+	}
+}
+
+// ServeHTTP renders the metrics in the Prometheus text exposition format
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := make([]string, 0, len(m.jobs))
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	name := func(s string) string { return m.prefix + "_job_" + s }
+
+	cw.header(name("runs_total"), "counter", "Number of completed job runs.")
+	for _, id := range ids {
+		cw.sample(name("runs_total"), jobLabel(id), float64(m.jobs[id].runs))
+	}
+
+	cw.header(name("failures_total"), "counter", "Number of job runs that returned an error.")
+	for _, id := range ids {
+		cw.sample(name("failures_total"), jobLabel(id), float64(m.jobs[id].failures))
+	}
+
+	cw.header(name("skips_total"), "counter", "Number of occurrences that were not run.")
+	for _, id := range ids {
+		skips := m.jobs[id].skips
+		reasons := make([]string, 0, len(skips))
+		for reason := range skips {
+			reasons = append(reasons, reason)
+		}
+		sort.Strings(reasons)
+		for _, reason := range reasons {
+			labels := jobLabel(id) + `,reason="` + escapeLabel(reason) + `"`
+			cw.sample(name("skips_total"), labels, float64(skips[reason]))
+		}
+	}
+
+	cw.header(name("duration_seconds"), "histogram", "Duration of job runs in seconds.")
+	for _, id := range ids {
+		cw.histogram(name("duration_seconds"), jobLabel(id), m.jobs[id].duration)
+	}
+
+	cw.header(name("lag_seconds"), "histogram", "Delay between the nominal schedule time and the actual start in seconds.")
+	for _, id := range ids {
+		cw.histogram(name("lag_seconds"), jobLabel(id), m.jobs[id].lag)
+	}
+
+	cw.header(name("next_run_timestamp_seconds"), "gauge", "Unix time of the next nominal run, 0 if there is none.")
+	for _, id := range ids {
+		cw.sample(name("next_run_timestamp_seconds"), jobLabel(id), m.jobs[id].nextRun)
+	}
+
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// countingWriter writes exposition lines and remembers the first error
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) printf(format string, args ...any) {
+	if cw.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(cw.w, format, args...)
+	cw.n += int64(n)
+	cw.err = err
+}
+
+func (cw *countingWriter) header(name, typ, help string) {
+	cw.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+func (cw *countingWriter) sample(name, labels string, v float64) {
+	cw.printf("%s{%s} %s\n", name, labels, formatFloat(v))
+}
+
+func (cw *countingWriter) histogram(name, labels string, h *histogram) {
+	for i, b := range h.bounds {
+		cw.printf("%s_bucket{%s,le=\"%s\"} %d\n", name, labels, formatFloat(b), h.counts[i])
+	}
+	cw.printf("%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, h.count)
+	cw.printf("%s_sum{%s} %s\n", name, labels, formatFloat(h.sum))
+	cw.printf("%s_count{%s} %d\n", name, labels, h.count)
+}
+
+func jobLabel(id string) string {
+	return `job="` + escapeLabel(id) + `"`
+}
+
+// escapeLabel escapes a label value as required by the exposition format
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
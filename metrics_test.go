@@ -0,0 +1,83 @@
+package expressparser
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetrics_Exposition(t *testing.T) {
+	m := NewMetrics(WithDurationBuckets(1, 0.1), WithLagBuckets(0.5))
+	s := NewScheduler(mustParseExpr(t, "0 9 * * *"))
+	nominal := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	m.OnEvent(NewEvent(EventScheduled, s, "backup", nominal))
+
+	started := NewEvent(EventStarted, s, "backup", nominal)
+	started.Start = nominal.Add(200 * time.Millisecond)
+	m.OnEvent(started)
+
+	finished := started
+	finished.Type = EventFinished
+	finished.Duration = 500 * time.Millisecond
+	m.OnEvent(finished)
+
+	failed := started
+	failed.Type = EventFailed
+	failed.Duration = 2 * time.Second
+	failed.Err = errors.New("boom")
+	m.OnEvent(failed)
+
+	skipped := NewEvent(EventSkipped, s, `we"ird`, nominal)
+	skipped.Reason = SkipLocked
+	m.OnEvent(skipped)
+
+	overrun := NewEvent(EventSkipped, s, "backup", nominal)
+	overrun.Reason = SkipOverrun
+	overrun.Missed = 3600
+	m.OnEvent(overrun)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("Content-Type = %q", ct)
+	}
+
+	out := rec.Body.String()
+	for _, want := range []string{
+		"# TYPE cron_job_runs_total counter",
+		`cron_job_runs_total{job="backup"} 2`,
+		`cron_job_failures_total{job="backup"} 1`,
+		`cron_job_skips_total{job="we\"ird",reason="locked"} 1`,
+		`cron_job_skips_total{job="backup",reason="overrun"} 3600`,
+		"# TYPE cron_job_duration_seconds histogram",
+		`cron_job_duration_seconds_bucket{job="backup",le="0.1"} 0`,
+		`cron_job_duration_seconds_bucket{job="backup",le="1"} 1`,
+		`cron_job_duration_seconds_bucket{job="backup",le="+Inf"} 2`,
+		`cron_job_duration_seconds_sum{job="backup"} 2.5`,
+		`cron_job_duration_seconds_count{job="backup"} 2`,
+		`cron_job_lag_seconds_bucket{job="backup",le="0.5"} 1`,
+		`cron_job_lag_seconds_sum{job="backup"} 0.2`,
+		`cron_job_next_run_timestamp_seconds{job="backup"} 1.7040996e+09`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestMetrics_Prefix(t *testing.T) {
+	m := NewMetrics(WithMetricsPrefix("myapp"))
+	m.OnEvent(Event{Type: EventFinished, JobID: "a"})
+
+	var b strings.Builder
+	if _, err := m.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	if !strings.Contains(b.String(), `myapp_job_runs_total{job="a"} 1`) {
+		t.Errorf("output does not use prefix:\n%s", b.String())
+	}
+}
@@ -0,0 +1,171 @@
+// middleware.go - Composable job wrappers for Runner
+
+package expressparser
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+)
+
+// JobWrapper decorates a Job with additional behaviour
+type JobWrapper func(Job) Job
+
+// Chain composes wrappers into a single JobWrapper
+//
+// The first wrapper is the outermost one: Chain(a, b, c)(job) is equivalent
+// to a(b(c(job))). The recommended order for the built-in wrappers is
+//
+//	Chain(Logger(l), Retry(3, backoff), Recover(), Timeout(d))
+//
+// which logs the whole run once, turns a panic in any attempt into an error
+// that is retried like any other, and applies the timeout to each attempt
+// separately. With Recover outside Retry a panic would abort the remaining
+// attempts.
+func Chain(wrappers ...JobWrapper) JobWrapper {
+	return func(job Job) Job {
+		for i := len(wrappers) - 1; i >= 0; i-- {
+			job = wrappers[i](job)
+		}
+		return job
+	}
+}
+
+// JobInfo describes the occurrence a job is being run for
+type JobInfo struct {
+	ID        string    // Job ID as registered with the Runner
+	Scheduled time.Time // Nominal occurrence time from Scheduler.Next
+}
+
+type jobInfoKey struct{}
+
+// withJobInfo returns a context carrying info
+func withJobInfo(ctx context.Context, info JobInfo) context.Context {
+	return context.WithValue(ctx, jobInfoKey{}, info)
+}
+
+// JobInfoFromContext returns the occurrence a Runner is executing
+//
+// The second return value is false when ctx was not created by a Runner.
+func JobInfoFromContext(ctx context.Context) (JobInfo, bool) {
+	info, ok := ctx.Value(jobInfoKey{}).(JobInfo)
+	return info, ok
+}
+
+// Recover converts panics raised by the job into a *PanicError
+func Recover() JobWrapper {
+	return func(job Job) Job {
+		return func(ctx context.Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = &PanicError{Value: r, Stack: debug.Stack()}
+				}
+			}()
+			return job(ctx)
+		}
+	}
+}
+
+// Timeout limits each run of the job to d
+//
+// The deadline is applied through the job's context, so the job must honour
+// ctx for the timeout to take effect.
+func Timeout(d time.Duration) JobWrapper {
+	return func(job Job) Job {
+		return func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			return job(ctx)
+		}
+	}
+}
+
+// Backoff returns how long to wait before the given retry attempt (1-based)
+type Backoff func(attempt int) time.Duration
+
+// ExponentialBackoff doubles the delay after each attempt, starting at
+// initial and never exceeding max
+func ExponentialBackoff(initial, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		d := initial
+		for i := 1; i < attempt; i++ {
+			d *= 2
+			if d >= max || d <= 0 {
+				return max
+			}
+		}
+		if d > max {
+			return max
+		}
+		return d
+	}
+}
+
+// Retry runs the job up to maxAttempts times until it succeeds
+//
+// backoff is consulted before every retry; a nil backoff retries immediately.
+// Retrying stops early when ctx is done, and the last error is returned.
+func Retry(maxAttempts int, backoff Backoff) JobWrapper {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return func(job Job) Job {
+		return func(ctx context.Context) error {
+			var err error
+			for attempt := 1; attempt <= maxAttempts; attempt++ {
+				if err = job(ctx); err == nil {
+					return nil
+				}
+				if attempt == maxAttempts {
+					break
+				}
+
+				var delay time.Duration
+				if backoff != nil {
+					delay = backoff(attempt)
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return err
+				case <-timer.C:
+				}
+			}
+			return err
+		}
+	}
+}
+
+// Logger records the start and end of every run with log/slog
+//
+// Records include the job ID and scheduled time when the job is run by a
+// Runner. Failed runs are logged at error level.
+func Logger(logger *slog.Logger) JobWrapper {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return func(job Job) Job {
+		return func(ctx context.Context) error {
+			var attrs []any
+			if info, ok := JobInfoFromContext(ctx); ok {
+				attrs = append(attrs,
+					slog.String("job", info.ID),
+					slog.Time("scheduled", info.Scheduled))
+			}
+
+			logger.InfoContext(ctx, "job started", attrs...)
+			start := time.Now()
+			err := job(ctx)
+			attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+			if err != nil {
+				logger.ErrorContext(ctx, "job failed", append(attrs, slog.Any("error", err))...)
+				return err
+			}
+			logger.InfoContext(ctx, "job finished", attrs...)
+			return nil
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package expressparser
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChain_Order(t *testing.T) {
+	var calls []string
+	tag := func(name string) JobWrapper {
+		return func(job Job) Job {
+			return func(ctx context.Context) error {
+				calls = append(calls, name)
+				return job(ctx)
+			}
+		}
+	}
+
+	job := Chain(tag("a"), tag("b"), tag("c"))(func(ctx context.Context) error {
+		calls = append(calls, "job")
+		return nil
+	})
+	if err := job(context.Background()); err != nil {
+		t.Fatalf("job error = %v", err)
+	}
+
+	want := "a,b,c,job"
+	if got := strings.Join(calls, ","); got != want {
+		t.Errorf("call order = %q, want %q", got, want)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	job := Recover()(func(ctx context.Context) error {
+		panic("boom")
+	})
+
+	err := job(context.Background())
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("error = %v, want *PanicError", err)
+	}
+	if panicErr.Value != "boom" || len(panicErr.Stack) == 0 {
+		t.Errorf("PanicError = {%v, %d bytes of stack}, want value boom with stack", panicErr.Value, len(panicErr.Stack))
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	job := Timeout(10 * time.Millisecond)(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	if err := job(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	attempts := 0
+	job := Retry(3, nil)(func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errFlaky
+		}
+		return nil
+	})
+	if err := job(context.Background()); err != nil {
+		t.Errorf("error = %v, want nil after third attempt", err)
+	}
+
+	attempts = 0
+	job = Retry(2, nil)(func(ctx context.Context) error {
+		attempts++
+		return errFlaky
+	})
+	if err := job(context.Background()); !errors.Is(err, errFlaky) || attempts != 2 {
+		t.Errorf("error = %v after %d attempts, want %v after 2", err, attempts, errFlaky)
+	}
+}
+
+func TestRetry_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	job := Retry(5, func(int) time.Duration { return time.Hour })(func(ctx context.Context) error {
+		attempts++
+		cancel()
+		return errors.New("failed")
+	})
+
+	if err := job(ctx); err == nil || attempts != 1 {
+		t.Errorf("error = %v after %d attempts, want error after 1", err, attempts)
+	}
+}
+
+func TestChain_RecoverInsideRetry(t *testing.T) {
+	attempts := 0
+	job := Chain(Retry(3, nil), Recover())(func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			panic("boom")
+		}
+		return nil
+	})
+
+	if err := job(context.Background()); err != nil || attempts != 3 {
+		t.Errorf("error = %v after %d attempts, want success after 3", err, attempts)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := ExponentialBackoff(100*time.Millisecond, time.Second)
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{100, time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := b(tt.attempt); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	job := Logger(logger)(func(ctx context.Context) error {
+		return errors.New("disk full")
+	})
+	ctx := withJobInfo(context.Background(), JobInfo{ID: "backup", Scheduled: time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC)})
+	job(ctx)
+
+	out := buf.String()
+	for _, want := range []string{`msg="job started"`, `msg="job failed"`, "job=backup", `error="disk full"`, "duration="} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunner_WithChain(t *testing.T) {
+	r := NewRunner(WithChain(Recover()))
+	err := r.Add("panics", mustSchedule(t, "* * * * *"), func(ctx context.Context) error {
+		info, ok := JobInfoFromContext(ctx)
+		if !ok || info.ID != "panics" {
+			t.Errorf("JobInfoFromContext() = %+v, %v", info, ok)
+		}
+		panic("boom")
+	})
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	err = r.runOccurrence(context.Background(), r.entries[0], time.Now())
+	if !IsPanicError(err) {
+		t.Errorf("runOccurrence() error = %v, want *PanicError", err)
+	}
+}
@@ -0,0 +1,1066 @@
+// natural.go - Parser for schedules written in plain English
+
+package expressparser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParseNatural parses a schedule written in English, such as "every weekday
+// at 9:30am" or "first Monday of each month at noon", into an Expression
+//
+// The grammar covers every English description DescribeWithOptions produces
+// without a Location or ViewerLocation, so such a description parses back
+// to an equivalent expression, plus common shorthand:
+//
+//   - times: "at 9:30am", "at 17:45", "at noon", "at 9:00 AM, 5:00 PM",
+//     "at 9:00:30 AM", "at second 30", "at minute 0 and 30",
+//     "at minute 15 of every hour", "at 5 minutes past every hour",
+//     "between 9am and 5pm", "from 9am to 5pm",
+//     "at minute 0, every hour between 9am and 5pm"
+//   - repetition: "every 15 minutes", "every 2 hours starting at 1:00 AM",
+//     "every other day", "every second", "hourly", "daily", "weekly",
+//     "monthly", "yearly"
+//   - days of the week: "every weekday", "on weekends", "on Mondays and
+//     Fridays", "from Monday through Friday", "first Monday of each month",
+//     "last Friday of the month"
+//   - days of the month: "on the 1st and 15th", "on day 15 of the month",
+//     "on the last day of the month", "on the last weekday of the month",
+//     "on the weekday nearest to day 15 of the month", "every 2 days"
+//   - months: "in June", "in January and July", "from March through
+//     September", "every 3 months"
+//
+// Clauses may come in any order, separated by commas, "and" or "or". A
+// schedule without a time of day runs at midnight, "every month" and "every
+// 3 months" without a day run on the 1st, "every year" runs on January 1st,
+// and days of the month and days of the week combine with cron's OR
+// semantics. "every second Monday" is rejected as ambiguous; write "second
+// Monday of the month".
+//
+// Phrasing outside the grammar is reported as a *ParseError whose Offset and
+// Length locate the offending word in text.
+//
+// Example:
+//
+//	expr, err := expressparser.ParseNatural("every weekday at 9:30am")
+//	// expr.String() == "30 9 * * 1-5"
+func ParseNatural(text string) (*Expression, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, ErrEmptyExpression
+	}
+
+	p := &naturalParser{text: text, tokens: tokenizeNatural(text), minuteHint: -1}
+	for !p.done() {
+		if _, ok := p.acceptAny(",", "and", "or"); ok {
+			continue
+		}
+		if err := p.clause(); err != nil {
+			return nil, err
+		}
+		p.clauses++
+	}
+	return p.expression()
+}
+
+var (
+	naturalTokenPattern = regexp.MustCompile(`(?i)[a-z0-9:#.']+(\(s\))?|,`)
+
+	// naturalTimePattern matches "9", "9:30", "9:30:15" and the same with
+	// an AM/PM marker attached
+	naturalTimePattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(?::(\d{2}))?(am|pm)?$`)
+
+	naturalOrdinalPattern = regexp.MustCompile(`^(\d{1,2})(st|nd|rd|th)$`)
+)
+
+var naturalWeekdays = map[string]int{
+	"sunday": 0, "sun": 0,
+	"monday": 1, "mon": 1,
+	"tuesday": 2, "tue": 2, "tues": 2,
+	"wednesday": 3, "wed": 3,
+	"thursday": 4, "thu": 4, "thur": 4, "thurs": 4,
+	"friday": 5, "fri": 5,
+	"saturday": 6, "sat": 6,
+}
+
+var naturalMonths = map[string]int{
+	"january": 1, "jan": 1,
+	"february": 2, "feb": 2,
+	"march": 3, "mar": 3,
+	"april": 4, "apr": 4,
+	"may":  5,
+	"june": 6, "jun": 6,
+	"july": 7, "jul": 7,
+	"august": 8, "aug": 8,
+	"september": 9, "sep": 9, "sept": 9,
+	"october": 10, "oct": 10,
+	"november": 11, "nov": 11,
+	"december": 12, "dec": 12,
+}
+
+var naturalOrdinals = map[string]int{
+	"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5,
+}
+
+// naturalToken is a word of the text being parsed
+type naturalToken struct {
+	text   string // Lower-cased word without "(s)" or a trailing period
+	offset int    // Byte offset of the word in the text
+	length int    // Byte length of the word in the text
+}
+
+func tokenizeNatural(text string) []naturalToken {
+	var tokens []naturalToken
+	for _, loc := range naturalTokenPattern.FindAllStringIndex(text, -1) {
+		word := strings.TrimSuffix(strings.ToLower(text[loc[0]:loc[1]]), "(s)")
+		switch word {
+		case "a.m.", "a.m":
+			word = "am"
+		case "p.m.", "p.m":
+			word = "pm"
+		default:
+			word = strings.TrimRight(word, ".")
+		}
+		if word == "" {
+			continue
+		}
+
+		// Split a time from its marker, as in "9:30am"
+		if m := naturalTimePattern.FindStringSubmatch(word); m != nil && m[4] != "" {
+			n := len(word) - 2
+			tokens = append(tokens,
+				naturalToken{text: word[:n], offset: loc[0], length: n},
+				naturalToken{text: word[n:], offset: loc[0] + n, length: 2})
+			continue
+		}
+		tokens = append(tokens, naturalToken{text: word, offset: loc[0], length: loc[1] - loc[0]})
+	}
+	return tokens
+}
+
+// naturalField collects the cron text of one field
+type naturalField struct {
+	items []string
+	step  int
+	base  string // Start or range the step applies to
+}
+
+func (f *naturalField) isSet() bool {
+	return len(f.items) > 0 || f.step > 0
+}
+
+// isRepeating reports whether the field holds more than a single value
+func (f *naturalField) isRepeating() bool {
+	if !f.isSet() {
+		return false
+	}
+	if f.step > 0 || len(f.items) > 1 {
+		return true
+	}
+	_, err := strconv.Atoi(f.items[0])
+	return err != nil
+}
+
+// add appends list items. A lone range narrows a pending step, as in
+// "every 2 hours, between 9 AM and 5 PM".
+func (f *naturalField) add(items ...string) {
+	if f.step > 0 && f.base == "*" && len(items) == 1 && strings.Contains(items[0], "-") {
+		f.base = items[0]
+		return
+	}
+	f.items = append(f.items, items...)
+}
+
+// every sets a step starting at start, or across the field if start is "*"
+func (f *naturalField) every(step int, start string) {
+	f.step = step
+	f.base = start
+}
+
+// text returns the field's cron text, or def if nothing was set
+func (f *naturalField) text(def string) string {
+	items := f.items
+	if f.step > 0 {
+		items = append([]string{f.base + "/" + strconv.Itoa(f.step)}, items...)
+	}
+	if len(items) == 0 {
+		return def
+	}
+	return strings.Join(items, ",")
+}
+
+// naturalTime is a time of day
+type naturalTime struct {
+	hour, minute, second int
+	hasSeconds           bool
+}
+
+type naturalParser struct {
+	text    string
+	tokens  []naturalToken
+	pos     int
+	clauses int
+
+	second, minute, hour, dayOfMonth, month, dayOfWeek naturalField
+
+	// minuteHint is the minute of a time given with "starting at" or
+	// "between", used when no minute is set otherwise; -1 if none
+	minuteHint int
+
+	// dayDefault and monthDefault stand in for the day of the month and the
+	// month when neither day field nor the month is given, as "every month"
+	// runs on the 1st and "every year" on January 1st; "" if none. everyDay
+	// is set by "every day", which overrides dayDefault.
+	dayDefault, monthDefault string
+	everyDay                 bool
+
+	// timeMinute and timeSecond are those of the first time of day, which
+	// later times must share; hasTime is set once there is one
+	timeMinute, timeSecond int
+	hasTime                bool
+}
+
+func (p *naturalParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+// peek returns the word k tokens ahead, or "" past the end
+func (p *naturalParser) peek(k int) string {
+	if p.pos+k >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos+k].text
+}
+
+// accept consumes words if they come next, in order
+func (p *naturalParser) accept(words ...string) bool {
+	for i, w := range words {
+		if p.peek(i) != w {
+			return false
+		}
+	}
+	p.pos += len(words)
+	return true
+}
+
+// acceptAny consumes the next word if it is one of words
+func (p *naturalParser) acceptAny(words ...string) (string, bool) {
+	next := p.peek(0)
+	for _, w := range words {
+		if next == w {
+			p.pos++
+			return w, true
+		}
+	}
+	return "", false
+}
+
+func (p *naturalParser) expect(words ...string) error {
+	for _, w := range words {
+		if !p.accept(w) {
+			return p.errorf("expected %q", w)
+		}
+	}
+	return nil
+}
+
+// errorf reports a problem with the next word
+func (p *naturalParser) errorf(format string, args ...any) *ParseError {
+	return p.errorAt(p.pos, fmt.Sprintf(format, args...))
+}
+
+// errorAt reports a problem with the word at pos
+func (p *naturalParser) errorAt(pos int, reason string) *ParseError {
+	err := &ParseError{Expression: p.text, Offset: len(p.text)}
+	if pos >= len(p.tokens) {
+		err.Reason = reason + " at end of text"
+		return err
+	}
+	tok := p.tokens[pos]
+	err.Value = p.text[tok.offset : tok.offset+tok.length]
+	err.Reason = fmt.Sprintf("%s at %q", reason, err.Value)
+	err.Offset, err.Length = tok.offset, tok.length
+	return err
+}
+
+// clause parses one phrase of the schedule
+func (p *naturalParser) clause() error {
+	word := p.peek(0)
+	switch word {
+	case "every", "each":
+		p.pos++
+		return p.every()
+	case "at":
+		p.pos++
+		return p.at()
+	case "on":
+		p.pos++
+		return p.on()
+	case "in":
+		p.pos++
+		return p.months()
+	case "from":
+		p.pos++
+		return p.from()
+	case "between":
+		p.pos++
+		return p.between()
+	case "during":
+		p.pos++
+		if _, ok := p.acceptAny("hour", "hours"); !ok {
+			return p.errorf(`expected "hour"`)
+		}
+		items, err := p.values(FieldHour, false)
+		p.hour.add(items...)
+		return err
+	case "the":
+		p.pos++
+		return p.day()
+	case "daily", "everyday", "nightly":
+		p.pos++
+		p.everyDay = true
+		return nil
+	case "hourly":
+		p.pos++
+		p.hour.add("*")
+		return nil
+	case "weekly":
+		p.pos++
+		p.dayOfWeek.add("0")
+		return nil
+	case "monthly":
+		p.pos++
+		p.dayDefault = "1"
+		return nil
+	case "yearly", "annually":
+		p.pos++
+		p.dayDefault, p.monthDefault = "1", "1"
+		return nil
+	case "weekdays":
+		p.pos++
+		p.dayOfWeek.add("1-5")
+		return nil
+	case "weekends":
+		p.pos++
+		p.dayOfWeek.add("0", "6")
+		return nil
+	}
+
+	switch {
+	case p.isTime(0):
+		return p.times()
+	case isNaturalWeekday(word):
+		return p.weekdays()
+	case word == "last" || p.isNthWeekday(0):
+		return p.day()
+	}
+	return p.errorf("unsupported phrase")
+}
+
+// every parses what follows "every"
+func (p *naturalParser) every() error {
+	if p.accept("other") {
+		return p.everyN(2)
+	}
+	// "every second Monday" may mean every other Monday, which cron cannot
+	// express, so only "every second Monday of the month" is accepted
+	if p.isNthWeekday(0) {
+		if p.peek(2) != "of" {
+			first, day := p.tokens[p.pos], p.tokens[p.pos+1]
+			return p.errorf(`ambiguous repetition; write "%s of the month"`,
+				p.text[first.offset:day.offset+day.length])
+		}
+		return p.day()
+	}
+	if n, ok := p.number(); ok {
+		return p.everyN(n)
+	}
+
+	word := p.peek(0)
+	switch word {
+	case "second":
+		p.second.add("*")
+	case "minute":
+		p.minute.add("*")
+	case "hour":
+		// "every hour between 9 AM and 5 PM" leaves the hours to the range
+		if p.peek(1) != "between" {
+			p.hour.add("*")
+		}
+	case "day", "night":
+		p.everyDay = true
+	case "month":
+		p.dayDefault = "1"
+	case "year":
+		p.dayDefault, p.monthDefault = "1", "1"
+	case "weekday":
+		p.dayOfWeek.add("1-5")
+	case "weekend":
+		p.dayOfWeek.add("0", "6")
+	case "week":
+		p.dayOfWeek.add("0")
+	default:
+		switch {
+		case isNaturalWeekday(word):
+			return p.weekdays()
+		case isNaturalMonth(word):
+			return p.months()
+		}
+		return p.errorf("unsupported repetition")
+	}
+	p.pos++
+	return nil
+}
+
+// everyN parses the unit and start of "every N ..."
+func (p *naturalParser) everyN(n int) error {
+	pos := p.pos - 1
+	unit, ok := p.acceptAny("seconds", "second", "minutes", "minute", "hours", "hour",
+		"days", "day", "months", "month")
+	if !ok {
+		return p.errorf("expected seconds, minutes, hours, days or months")
+	}
+
+	switch strings.TrimSuffix(unit, "s") {
+	case "second":
+		return p.everySubHour(&p.second, FieldSecond, n, pos)
+	case "minute":
+		return p.everySubHour(&p.minute, FieldMinute, n, pos)
+
+	case "hour":
+		if err := p.checkStep(FieldHour, n, pos); err != nil {
+			return err
+		}
+		start := "*"
+		if p.accept("starting", "at") || p.accept("starting", "from") {
+			t, err := p.time()
+			if err != nil {
+				return err
+			}
+			start = strconv.Itoa(t.hour)
+			p.minuteHint = t.minute
+		}
+		p.hour.every(n, start)
+
+	case "day":
+		if err := p.checkStep(FieldDayOfMonth, n, pos); err != nil {
+			return err
+		}
+		start := "*"
+		if p.accept("starting", "on") || p.accept("starting", "from") {
+			if !p.accept("day") {
+				p.accept("the")
+			}
+			day, err := p.value(FieldDayOfMonth, true)
+			if err != nil {
+				return err
+			}
+			p.ofMonth()
+			start = strconv.Itoa(day)
+		}
+		p.dayOfMonth.every(n, start)
+
+	case "month":
+		if err := p.checkStep(FieldMonth, n, pos); err != nil {
+			return err
+		}
+		start := "*"
+		if p.accept("starting", "in") || p.accept("starting", "from") {
+			m, ok := naturalMonths[p.peek(0)]
+			if !ok {
+				return p.errorf("expected a month")
+			}
+			p.pos++
+			start = strconv.Itoa(m)
+		}
+		p.month.every(n, start)
+		p.dayDefault = "1"
+	}
+	return nil
+}
+
+// everySubHour parses the start of "every N seconds" or "every N minutes"
+func (p *naturalParser) everySubHour(f *naturalField, field FieldType, n, pos int) error {
+	if err := p.checkStep(field, n, pos); err != nil {
+		return err
+	}
+	start := "*"
+	if p.accept("starting", "at", string(field)) {
+		v, err := p.value(field, false)
+		if err != nil {
+			return err
+		}
+		start = strconv.Itoa(v)
+	}
+	f.every(n, start)
+	return nil
+}
+
+// checkStep validates the step at pos
+func (p *naturalParser) checkStep(field FieldType, n, pos int) error {
+	if n < 1 || n > fieldBounds[field].max {
+		return p.errorAt(pos, fmt.Sprintf("step must be between 1 and %d", fieldBounds[field].max))
+	}
+	return nil
+}
+
+// at parses what follows "at"
+func (p *naturalParser) at() error {
+	switch {
+	case p.accept("second") || p.accept("seconds"):
+		items, err := p.values(FieldSecond, false)
+		if err != nil {
+			return err
+		}
+		p.second.add(items...)
+		if p.accept("of", "every", "minute") || p.accept("past", "the", "minute") {
+			p.minute.add("*")
+		}
+		return nil
+
+	case p.accept("minute") || p.accept("minutes"):
+		items, err := p.values(FieldMinute, false)
+		if err != nil {
+			return err
+		}
+		p.minute.add(items...)
+		if !p.accept("of") && !p.accept("past") {
+			return nil
+		}
+		if p.accept("every", "hour") || p.accept("each", "hour") || p.accept("the", "hour") {
+			p.hour.add("*")
+			return nil
+		}
+		times, err := p.timeList()
+		for _, t := range times {
+			p.hour.add(strconv.Itoa(t.hour))
+		}
+		return err
+	}
+
+	// "at 5 minutes past every hour"
+	if unit := p.peek(1); unit == "minute" || unit == "minutes" {
+		m, err := p.value(FieldMinute, false)
+		if err != nil {
+			return err
+		}
+		p.pos++
+		if !p.accept("past", "every", "hour") && !p.accept("past", "each", "hour") && !p.accept("past", "the", "hour") {
+			return p.errorf(`expected "past every hour"`)
+		}
+		p.minute.add(strconv.Itoa(m))
+		p.hour.add("*")
+		return nil
+	}
+
+	return p.times()
+}
+
+// times parses a list of times of day, all at the same minute and second
+func (p *naturalParser) times() error {
+	start := p.pos
+	times, err := p.timeList()
+	if err != nil {
+		return err
+	}
+
+	first := times[0]
+	if p.hasTime {
+		first.minute, first.second = p.timeMinute, p.timeSecond
+	}
+	for _, t := range times {
+		if t.minute != first.minute || t.second != first.second {
+			return p.errorAt(start, "times with different minutes cannot be combined in one expression")
+		}
+	}
+	if !p.hasTime {
+		p.hasTime = true
+		p.timeMinute, p.timeSecond = first.minute, first.second
+		p.minute.add(strconv.Itoa(first.minute))
+		if times[0].hasSeconds || first.second != 0 {
+			p.second.add(strconv.Itoa(first.second))
+		}
+	}
+	for _, t := range times {
+		p.hour.add(strconv.Itoa(t.hour))
+	}
+
+	// "at 9:00 AM and 30 seconds"
+	if unit := p.peek(2); p.peek(0) == "and" && (unit == "second" || unit == "seconds") {
+		p.pos++
+		s, err := p.value(FieldSecond, false)
+		if err != nil {
+			return err
+		}
+		p.pos++
+		p.second.add(strconv.Itoa(s))
+	}
+	return nil
+}
+
+// timeList parses one or more times of day
+func (p *naturalParser) timeList() ([]naturalTime, error) {
+	var times []naturalTime
+	for {
+		t, err := p.time()
+		if err != nil {
+			return nil, err
+		}
+		times = append(times, t)
+		if !p.listContinues(p.isTime) {
+			return times, nil
+		}
+	}
+}
+
+// isTime reports whether the word k tokens ahead starts a time of day
+func (p *naturalParser) isTime(k int) bool {
+	word := p.peek(k)
+	if word == "noon" || word == "midnight" {
+		return true
+	}
+	switch p.peek(k + 1) {
+	case "second", "seconds", "minute", "minutes", "hour", "hours", "day", "days", "month", "months":
+		return false
+	}
+	return naturalTimePattern.MatchString(word)
+}
+
+// time parses a time of day such as "9", "9:30 am", "17:45:10" or "noon"
+func (p *naturalParser) time() (naturalTime, error) {
+	start := p.pos
+	switch {
+	case p.accept("noon"):
+		return naturalTime{hour: 12}, nil
+	case p.accept("midnight"):
+		return naturalTime{}, nil
+	}
+
+	m := naturalTimePattern.FindStringSubmatch(p.peek(0))
+	if m == nil {
+		return naturalTime{}, p.errorf("expected a time such as 9:30am or 17:00")
+	}
+	p.pos++
+
+	var t naturalTime
+	t.hour, _ = strconv.Atoi(m[1])
+	if m[2] != "" {
+		t.minute, _ = strconv.Atoi(m[2])
+	}
+	if m[3] != "" {
+		t.second, _ = strconv.Atoi(m[3])
+		t.hasSeconds = true
+	}
+	if marker, ok := p.acceptAny("am", "pm"); ok {
+		if t.hour < 1 || t.hour > 12 {
+			return naturalTime{}, p.errorAt(start, "hour must be between 1 and 12 with AM or PM")
+		}
+		t.hour %= 12
+		if marker == "pm" {
+			t.hour += 12
+		}
+	}
+	p.accept("o'clock")
+	if t.hour > 23 || t.minute > 59 || t.second > 59 {
+		return naturalTime{}, p.errorAt(start, "invalid time")
+	}
+	return t, nil
+}
+
+// on parses what follows "on"
+func (p *naturalParser) on() error {
+	switch {
+	case p.accept("weekdays"):
+		p.dayOfWeek.add("1-5")
+	case p.accept("weekends"):
+		p.dayOfWeek.add("0", "6")
+	case p.accept("every") || p.accept("each"):
+		return p.every()
+	case p.accept("the"):
+		return p.day()
+	case p.accept("day") || p.accept("days"):
+		items, err := p.values(FieldDayOfMonth, true)
+		if err != nil {
+			return err
+		}
+		p.ofMonth()
+		p.dayOfMonth.add(items...)
+	case isNaturalWeekday(p.peek(0)):
+		return p.weekdays()
+	default:
+		return p.errorf("unsupported day")
+	}
+	return nil
+}
+
+// day parses a day of the month or an nth day of the week, after "the"
+func (p *naturalParser) day() error {
+	switch {
+	case p.accept("last"):
+		switch {
+		case p.accept("day"):
+			p.dayOfMonth.add("L")
+		case p.accept("weekday"):
+			p.dayOfMonth.add("LW")
+		case isNaturalWeekday(p.peek(0)):
+			p.dayOfWeek.add(strconv.Itoa(naturalWeekday(p.peek(0))) + "L")
+			p.pos++
+		default:
+			return p.errorf(`expected "day", "weekday" or a day of the week after "last"`)
+		}
+
+	case p.accept("day", "before", "the", "last", "day"):
+		p.dayOfMonth.add("L-1")
+
+	case p.accept("day"):
+		n, ok := p.number()
+		if !ok || n < 1 || n > 30 {
+			return p.errorAt(p.pos-1, "expected a number of days between 1 and 30")
+		}
+		if _, ok := p.acceptAny("days", "day"); !ok {
+			return p.errorf(`expected "days before the last day"`)
+		}
+		if err := p.expect("before", "the", "last", "day"); err != nil {
+			return err
+		}
+		p.dayOfMonth.add("L-" + strconv.Itoa(n))
+
+	case p.accept("weekday", "nearest", "to") || p.accept("nearest", "weekday", "to"):
+		if !p.accept("day") {
+			p.accept("the")
+		}
+		day, err := p.value(FieldDayOfMonth, true)
+		if err != nil {
+			return err
+		}
+		p.dayOfMonth.add(strconv.Itoa(day) + "W")
+
+	case p.isNthWeekday(0):
+		n := naturalOrdinal(p.peek(0))
+		p.dayOfWeek.add(fmt.Sprintf("%d#%d", naturalWeekday(p.peek(1)), n))
+		p.pos += 2
+
+	default:
+		items, err := p.values(FieldDayOfMonth, true)
+		if err != nil {
+			return err
+		}
+		p.dayOfMonth.add(items...)
+	}
+
+	p.ofMonth()
+	return nil
+}
+
+// ofMonth consumes an optional "of the month"
+func (p *naturalParser) ofMonth() {
+	_ = p.accept("of", "the", "month") || p.accept("of", "each", "month") ||
+		p.accept("of", "every", "month") || p.accept("of", "month") || p.accept("of", "a", "month")
+}
+
+// isNthWeekday reports whether the words k tokens ahead are an ordinal
+// between 1 and 5 and a day of the week, as in "2nd Monday"
+func (p *naturalParser) isNthWeekday(k int) bool {
+	n := naturalOrdinal(p.peek(k))
+	return n >= 1 && n <= 5 && isNaturalWeekday(p.peek(k+1))
+}
+
+// weekdays parses a list of days of the week and ranges of them
+func (p *naturalParser) weekdays() error {
+	for {
+		if !isNaturalWeekday(p.peek(0)) {
+			return p.errorf("expected a day of the week")
+		}
+		item := strconv.Itoa(naturalWeekday(p.peek(0)))
+		p.pos++
+		if _, ok := p.acceptAny("through", "to", "until", "thru"); ok {
+			if !isNaturalWeekday(p.peek(0)) {
+				return p.errorf("expected a day of the week")
+			}
+			item += "-" + strconv.Itoa(naturalWeekday(p.peek(0)))
+			p.pos++
+		}
+		p.dayOfWeek.add(item)
+
+		if !p.listContinues(func(k int) bool { return isNaturalWeekday(p.peek(k)) }) {
+			return nil
+		}
+	}
+}
+
+// months parses a list of months and ranges of them
+func (p *naturalParser) months() error {
+	for {
+		m, ok := naturalMonths[p.peek(0)]
+		if !ok {
+			return p.errorf("expected a month")
+		}
+		p.pos++
+		item := strconv.Itoa(m)
+		if _, ok := p.acceptAny("through", "to", "until", "thru"); ok {
+			end, ok := naturalMonths[p.peek(0)]
+			if !ok {
+				return p.errorf("expected a month")
+			}
+			p.pos++
+			item += "-" + strconv.Itoa(end)
+		}
+		p.month.add(item)
+
+		if !p.listContinues(func(k int) bool { return isNaturalMonth(p.peek(k)) }) {
+			return nil
+		}
+	}
+}
+
+// from parses what follows "from": a range of months, days of the week,
+// seconds, minutes or times
+func (p *naturalParser) from() error {
+	switch {
+	case isNaturalMonth(p.peek(0)):
+		return p.months()
+	case isNaturalWeekday(p.peek(0)):
+		return p.weekdays()
+	case p.accept("second"):
+		items, err := p.values(FieldSecond, false)
+		p.second.add(items...)
+		return err
+	case p.accept("minute"):
+		items, err := p.values(FieldMinute, false)
+		p.accept("past", "the", "hour")
+		p.minute.add(items...)
+		return err
+	}
+
+	from, err := p.time()
+	if err != nil {
+		return err
+	}
+	if _, ok := p.acceptAny("through", "to", "until", "thru"); !ok {
+		return p.errorf(`expected "to"`)
+	}
+	to, err := p.time()
+	if err != nil {
+		return err
+	}
+	p.hourRange(from, to)
+	return nil
+}
+
+// between parses "between TIME and TIME"
+func (p *naturalParser) between() error {
+	from, err := p.time()
+	if err != nil {
+		return err
+	}
+	if _, ok := p.acceptAny("and", "to"); !ok {
+		return p.errorf(`expected "and"`)
+	}
+	to, err := p.time()
+	if err != nil {
+		return err
+	}
+	p.hourRange(from, to)
+	return nil
+}
+
+// hourRange restricts the hours to those from one time through another,
+// wrapping past midnight if needed
+func (p *naturalParser) hourRange(from, to naturalTime) {
+	switch {
+	case from.hour == to.hour:
+		p.hour.add(strconv.Itoa(from.hour))
+	case from.hour < to.hour:
+		p.hour.add(fmt.Sprintf("%d-%d", from.hour, to.hour))
+	default:
+		p.hour.add(fmt.Sprintf("%d-23", from.hour), fmt.Sprintf("0-%d", to.hour))
+	}
+	if p.minuteHint < 0 {
+		p.minuteHint = from.minute
+	}
+}
+
+// values parses a list of numbers and ranges, or "every N starting at M"
+func (p *naturalParser) values(field FieldType, ordinals bool) ([]string, error) {
+	if p.accept("every") {
+		step, ok := p.number()
+		if !ok {
+			return nil, p.errorf("expected a number")
+		}
+		if err := p.checkStep(field, step, p.pos-1); err != nil {
+			return nil, err
+		}
+		if err := p.expect("starting", "at"); err != nil {
+			return nil, err
+		}
+		start, err := p.value(field, ordinals)
+		if err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf("%d/%d", start, step)}, nil
+	}
+
+	var items []string
+	for {
+		v, err := p.value(field, ordinals)
+		if err != nil {
+			return nil, err
+		}
+		item := strconv.Itoa(v)
+		if _, ok := p.acceptAny("through", "to", "until", "thru"); ok {
+			end, err := p.value(field, ordinals)
+			if err != nil {
+				return nil, err
+			}
+			item += "-" + strconv.Itoa(end)
+		}
+		items = append(items, item)
+
+		isValue := func(k int) bool {
+			if ordinals && naturalOrdinalPattern.MatchString(p.peek(k)) {
+				return true
+			}
+			_, err := strconv.Atoi(p.peek(k))
+			return err == nil
+		}
+		if !p.listContinues(isValue) {
+			return items, nil
+		}
+	}
+}
+
+// value parses a number, or an ordinal such as "15th" if ordinals is set,
+// within the bounds of field
+func (p *naturalParser) value(field FieldType, ordinals bool) (int, error) {
+	word := p.peek(0)
+	n, err := strconv.Atoi(word)
+	if err != nil && ordinals {
+		if m := naturalOrdinalPattern.FindStringSubmatch(word); m != nil {
+			n, err = strconv.Atoi(m[1])
+		}
+	}
+	if err != nil {
+		return 0, p.errorf("expected a number")
+	}
+
+	bounds := fieldBounds[field]
+	if n < bounds.min || n > bounds.max {
+		e := p.errorf("%s must be between %d and %d", field, bounds.min, bounds.max)
+		e.Field = string(field)
+		return 0, e
+	}
+	p.pos++
+	return n, nil
+}
+
+// number parses a plain number
+func (p *naturalParser) number() (int, bool) {
+	n, err := strconv.Atoi(p.peek(0))
+	if err != nil {
+		return 0, false
+	}
+	p.pos++
+	return n, true
+}
+
+// listContinues consumes a list separator ("," "and" or ", and") if another
+// item follows it, as decided by isItem for the word k tokens ahead
+func (p *naturalParser) listContinues(isItem func(k int) bool) bool {
+	k := 0
+	if p.peek(k) == "," {
+		k++
+	}
+	if p.peek(k) == "and" {
+		k++
+	}
+	if k == 0 || !isItem(k) {
+		return false
+	}
+	p.pos += k
+	return true
+}
+
+// expression assembles the collected fields, filling in what was left out
+func (p *naturalParser) expression() (*Expression, error) {
+	if p.clauses == 0 {
+		return nil, &ParseError{Expression: p.text, Reason: "no schedule found"}
+	}
+
+	timeSet := p.second.isSet() || p.minute.isSet() || p.hour.isSet()
+	minute, hour := "0", "*"
+	switch {
+	case p.second.isRepeating():
+		minute = "*"
+	case p.minuteHint >= 0:
+		minute = strconv.Itoa(p.minuteHint)
+	}
+	if !timeSet {
+		hour = "0"
+	}
+
+	day, month := "*", "*"
+	if p.dayDefault != "" && !p.everyDay && !p.dayOfWeek.isSet() {
+		day = p.dayDefault
+	}
+	if p.monthDefault != "" {
+		month = p.monthDefault
+	}
+
+	fields := []string{
+		p.minute.text(minute),
+		p.hour.text(hour),
+		p.dayOfMonth.text(day),
+		p.month.text(month),
+		p.dayOfWeek.text("*"),
+	}
+	if second := p.second.text("0"); second != "0" {
+		fields = append([]string{second}, fields...)
+	}
+
+	cron := strings.Join(fields, " ")
+	expr, err := Parse(cron)
+	if err != nil {
+		return nil, &ParseError{
+			Expression: p.text,
+			Value:      cron,
+			Reason:     "does not form a valid schedule: " + err.Error(),
+		}
+	}
+	return expr, nil
+}
+
+func isNaturalWeekday(word string) bool {
+	return naturalWeekday(word) >= 0
+}
+
+// naturalWeekday returns the number of a day name such as "Monday", "mon"
+// or "Mondays", or -1
+func naturalWeekday(word string) int {
+	if d, ok := naturalWeekdays[word]; ok {
+		return d
+	}
+	if d, ok := naturalWeekdays[strings.TrimSuffix(word, "s")]; ok && len(word) > 4 {
+		return d
+	}
+	return -1
+}
+
+func isNaturalMonth(word string) bool {
+	_, ok := naturalMonths[word]
+	return ok
+}
+
+// naturalOrdinal returns the number of an ordinal such as "first" or "2nd",
+// or 0
+func naturalOrdinal(word string) int {
+	if n, ok := naturalOrdinals[word]; ok {
+		return n
+	}
+	if m := naturalOrdinalPattern.FindStringSubmatch(word); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return n
+	}
+	return 0
+}
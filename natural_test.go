@@ -0,0 +1,231 @@
+package expressparser
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseNatural(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"every weekday at 9:30am", "30 9 * * 1-5"},
+		{"first Monday of each month at noon", "0 12 * * 1#1"},
+		{"every 5 minutes", "*/5 * * * *"},
+		{"every day at midnight", "0 0 * * *"},
+		{"daily", "0 0 * * *"},
+		{"hourly", "0 * * * *"},
+		{"at 6pm on Fridays", "0 18 * * 5"},
+		{"Mondays and Wednesdays at 8", "0 8 * * 1,3"},
+		{"on the 1st and 15th at 10 a.m.", "0 10 1,15 * *"},
+		{"last day of every month", "0 0 L * *"},
+		{"the last Friday of the month at 17:00", "0 17 * * 5L"},
+		{"every 15 minutes between 9am and 5pm on weekdays", "*/15 9-17 * * 1-5"},
+		{"from 9am to 5pm, every 30 minutes", "*/30 9-17 * * *"},
+		{"every 2 hours starting at 1am", "0 1/2 * * *"},
+		{"every other day at 6:30 pm", "30 18 */2 * *"},
+		{"in January and July on the 1st at noon", "0 12 1 1,7 *"},
+		{"at 9:00 AM, 5:00 PM from Monday through Friday", "0 9,17 * * 1-5"},
+		{"at 10:15:30", "30 15 10 * * *"},
+		{"every 10 seconds", "*/10 * * * * *"},
+		{"on the weekday nearest to the 15th at 8", "0 8 15W * *"},
+		{"between 10pm and 2am", "0 22-23,0-2 * * *"},
+		{"at minute 0, every hour between 9am and 5pm", "0 9-17 * * *"},
+		{"every month", "0 0 1 * *"},
+		{"every month at 9am", "0 9 1 * *"},
+		{"every month on the 15th", "0 0 15 * *"},
+		{"every 3 months", "0 0 1 */3 *"},
+		{"every day, every 3 months", "0 0 * */3 *"},
+		{"every year", "0 0 1 1 *"},
+		{"every year at noon in June", "0 12 1 6 *"},
+		{"every second Monday of the month at 9", "0 9 * * 1#2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseNatural(tt.text)
+			if err != nil {
+				t.Fatalf("ParseNatural() error: %v", err)
+			}
+			if !got.Equal(MustParse(tt.want)) {
+				t.Errorf("ParseNatural() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseNatural_RoundTrip(t *testing.T) {
+	exprs := []string{
+		"* * * * *",
+		"0 9 * * 1-5",
+		"30 14 1,15 * *",
+		"0 0 L * *",
+		"0 0 LW,L * *",
+		"0 0 L-3,10W * *",
+		"0 0 L-1 * *",
+		"0 0 L,15 * *",
+		"0 9 * * 1#2",
+		"0 0 * * 1#1,5L",
+		"0 9 * * 1,5L",
+		"0 0 1 * 1",
+		"0 0 L * 1-5",
+		"0 0 1,15 6 1#2",
+		"0 0 1 6 *",
+		"0 0 * 1-3 *",
+		"0 0 * 1,3,8 0,6",
+		"5 * * * *",
+		"0,30 * * * *",
+		"0 * * * *",
+		"0 9,17 * * *",
+		"0,30 9,12 * * *",
+		"0,20,40 8-18 * * *",
+		"0,15,30,45 9,10,11,12 * * *",
+		"0,30 */2 * * *",
+		"15,45 1/2 * * *",
+		"*/15 */2 * * *",
+		"* * * * * *",
+		"0,30 * * * * *",
+		"30 0 9 * * *",
+		"*/10 * * * * *",
+		"5/20 * * * * *",
+		"0-30/10 * * * * *",
+		"30 */5 * * * *",
+		"30 */5 9-17 * * *",
+		"15,45 */10 * * * *",
+		"*/10 */5 * * * *",
+		"30 0 1/2 * * *",
+		"* * 9 * * *",
+		"*/15 * * * *",
+		"*/15 9-17 * * *",
+		"5/15 * * * *",
+		"0-30/10 * * * *",
+		"* 9-17 * * *",
+		"0 */2 * * *",
+		"0 1/2 * * *",
+		"30 */3 * * *",
+		"0 9-17/2 * * *",
+		"0 9-17 * * *",
+		"30 9-17 * * 1-5",
+		"0 0 */2 * *",
+		"0 0 3/5 * *",
+		"0 0 1-15/2 * *",
+		"0 0 1 */3 *",
+		"0 0 * */3 *",
+		"0 0 1 2/3 *",
+		"0 0 1 3-9/2 *",
+		"0 0 * * */2",
+		"0 0 */2 * 1",
+	}
+
+	options := map[string]DescriptionOptions{
+		"default": {},
+		"24h":     {Use24HourTime: true},
+		"verbose": {Verbose: true},
+	}
+
+	for _, raw := range exprs {
+		expr := MustParse(raw)
+		for name, opts := range options {
+			desc := DescribeWithOptions(expr, opts)
+			got, err := ParseNatural(desc)
+			if err != nil {
+				t.Errorf("%s: ParseNatural(%q) (%s) error: %v", raw, desc, name, err)
+				continue
+			}
+			// Canonical forms usually match; Equal is the slower, exact check
+			if got.Canonical() != expr.Canonical() && !got.Equal(expr) {
+				t.Errorf("%s: ParseNatural(%q) (%s) = %q", raw, desc, name, got)
+			}
+		}
+	}
+}
+
+func TestParseNatural_RoundTripCombinations(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{"seconds of minutes", "30 0,30 * * * *"},
+		{"seconds past the hour", "30 5 * * * *"},
+		{"seconds list at a time", "15,45 0 9 * * *"},
+		{"second at several times", "30 0 9,17 * * *"},
+		{"seconds list every hour", "0,30 0 * * * *"},
+		{"seconds step every 2 hours", "*/10 0 */2 * * *"},
+		{"seconds list every 2 hours", "0,30 0 */2 * * *"},
+		{"last day offset and last weekday", "0 0 L-2,LW * *"},
+		{"nearest weekday", "0 0 15W * *"},
+		{"nearest weekday and last day", "0 0 1W,L * *"},
+		{"last day offset and nearest weekday in June", "0 0 L-2,15W 6 *"},
+		{"last weekday or Monday", "0 0 LW * 1"},
+		{"nth and last weekday", "0 9 * * 2#3,5L"},
+		{"two nth weekdays", "0 9 * * 1#1,1#3"},
+		{"fifth weekday", "0 9 * * 5#5"},
+		{"day of month or nth weekday", "0 9 1 * 2#3"},
+		{"minute list, hour step", "0,30 */2 * * *"},
+		{"minute list, hour step with offset", "10,20 1/4 * * *"},
+		{"even minute list", "0,15,30,45 9,10,11,12 * * *"},
+		{"day list, month step", "0 0 1,15 */2 *"},
+		{"day list, month range step", "0 0 1,15 1-6/2 *"},
+		{"hour step, day list", "0 */3 1,15 * *"},
+	}
+
+	options := map[string]DescriptionOptions{
+		"default": {},
+		"24h":     {Use24HourTime: true},
+		"verbose": {Verbose: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr := MustParse(tt.expr)
+			for name, opts := range options {
+				desc := DescribeWithOptions(expr, opts)
+				got, err := ParseNatural(desc)
+				if err != nil {
+					t.Errorf("ParseNatural(%q) (%s) error: %v", desc, name, err)
+					continue
+				}
+				if got.Canonical() != expr.Canonical() && !got.Equal(expr) {
+					t.Errorf("ParseNatural(%q) (%s) = %q, want %q", desc, name, got, tt.expr)
+				}
+			}
+		})
+	}
+}
+
+func TestParseNatural_Errors(t *testing.T) {
+	tests := []struct {
+		text   string
+		value  string
+		offset int
+	}{
+		{"every fortnight", "fortnight", 6},
+		{"at 25:00", "25:00", 3},
+		{"at 9am and 5:30pm", "9", 3},
+		{"at 13pm", "13", 3},
+		{"on the 32nd", "32nd", 7},
+		{"every 90 minutes", "90", 6},
+		{"twice a day", "twice", 0},
+		{"on the last", "", 11},
+		{"every second Monday", "second", 6},
+		{"every 3rd Friday at noon", "3rd", 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			_, err := ParseNatural(tt.text)
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("ParseNatural() error = %v, want *ParseError", err)
+			}
+			if pe.Value != tt.value || pe.Offset != tt.offset {
+				t.Errorf("ParseError at %q (%d), want %q (%d): %v", pe.Value, pe.Offset, tt.value, tt.offset, err)
+			}
+		})
+	}
+
+	if _, err := ParseNatural("  "); !errors.Is(err, ErrEmptyExpression) {
+		t.Errorf("ParseNatural(blank) = %v, want ErrEmptyExpression", err)
+	}
+}
@@ -0,0 +1,270 @@
+// overlap.go - Detection of coinciding occurrences across schedules
+
+package expressparser
+
+import (
+	"math/bits"
+	"sort"
+	"time"
+)
+
+// Overlap is a group of occurrences from two or more schedules that fire at
+// the same instant, or within the tolerance of each other
+type Overlap struct {
+	Start       time.Time // First occurrence in the group
+	End         time.Time // Last occurrence in the group, equal to Start with no tolerance
+	Schedulers  []int     // Indices of the schedulers involved, in ascending order
+	Occurrences int       // Number of occurrences in the group, across all schedulers
+}
+
+// OverlapReport is the result of FindOverlaps
+type OverlapReport struct {
+	// Overlaps in chronological order, at most the configured limit
+	Overlaps []Overlap
+
+	// Total number of overlaps found, including any beyond the limit
+	Total int
+
+	// Number of overlaps each pair of schedulers took part in, keyed by their
+	// indices with the lower index first
+	Pairs map[[2]int]int
+}
+
+type overlapFinder struct {
+	tolerance time.Duration
+	limit     int
+}
+
+// OverlapOption configures FindOverlaps
+type OverlapOption func(*overlapFinder)
+
+// WithTolerance treats occurrences as coinciding when they are at most d
+// apart. Occurrences are chained, so a group ends at the first gap longer
+// than d. The tolerance is rounded down to whole seconds and should be
+// shorter than an hour.
+func WithTolerance(d time.Duration) OverlapOption {
+	return func(f *overlapFinder) {
+		f.tolerance = d
+	}
+}
+
+// WithOverlapLimit keeps at most n overlaps in the report; Total and Pairs
+// still count every overlap. The default is 10000.
+func WithOverlapLimit(n int) OverlapOption {
+	return func(f *overlapFinder) {
+		f.limit = n
+	}
+}
+
+// hourBits is a set of seconds within an hour
+type hourBits [(3600 + 63) / 64]uint64
+
+func (b *hourBits) set(i int)      { b[i/64] |= 1 << (i % 64) }
+func (b *hourBits) has(i int) bool { return b[i/64]&(1<<(i%64)) != 0 }
+
+// FindOverlaps reports the occurrences in [from, to) at which two or more of
+// the schedulers fire together
+//
+// Each scheduler is evaluated in its own location. Rather than stepping
+// through every occurrence, the window is walked an hour at a time: month,
+// day and hour fields decide which schedulers are active in each hour, and
+// only hours where several are active are compared, using the intersection
+// of their minute and second fields.
+//
+// Example:
+//
+//	report := expressparser.FindOverlaps(schedulers, from, from.AddDate(0, 1, 0),
+//	    expressparser.WithTolerance(time.Minute))
+//	for _, o := range report.Overlaps {
+//	    fmt.Println(o.Start, o.Schedulers)
+//	}
+func FindOverlaps(schedulers []*Scheduler, from, to time.Time, opts ...OverlapOption) OverlapReport {
+	f := &overlapFinder{limit: 10000}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	report := OverlapReport{Pairs: make(map[[2]int]int)}
+	if len(schedulers) < 2 || !from.Before(to) {
+		return report
+	}
+
+	patterns := make([]hourBits, len(schedulers))
+	for i, s := range schedulers {
+		for _, m := range s.expr.GetMinutes() {
+			for _, sec := range s.expr.GetSeconds() {
+				patterns[i].set(m*60 + sec)
+			}
+		}
+	}
+
+	tol := int64(f.tolerance / time.Second)
+	scan := &overlapScan{finder: f, report: &report, tolerance: tol, from: from, to: to}
+
+	start := from.Truncate(time.Hour)
+	active := f.activeIn(schedulers, patterns, start)
+	for hour := start; hour.Before(to); hour = hour.Add(time.Hour) {
+		next := f.activeIn(schedulers, patterns, hour.Add(time.Hour))
+
+		// An hour only needs scanning if it can contain an overlap, or a
+		// group reaching into it from the previous hour or into the next
+		if len(active) >= 2 || (len(active) == 1 && (scan.open || (tol > 0 && len(next) > 0))) {
+			scan.hour(hour, active)
+		} else if scan.open && hour.Unix()-scan.end > tol {
+			scan.close()
+		}
+		active = next
+	}
+	scan.close()
+
+	return report
+}
+
+// activeHour is a scheduler's occurrences within one UTC hour
+type activeHour struct {
+	index int
+	bits  *hourBits
+}
+
+// activeIn returns the schedulers that fire during the hour starting at hour,
+// with the seconds of the hour at which they fire
+func (f *overlapFinder) activeIn(schedulers []*Scheduler, patterns []hourBits, hour time.Time) []activeHour {
+	var active []activeHour
+	for i, s := range schedulers {
+		local := hour.In(s.location)
+		offset := local.Minute()*60 + local.Second()
+
+		if offset == 0 {
+			if s.firesInHour(local) {
+				active = append(active, activeHour{index: i, bits: &patterns[i]})
+			}
+			continue
+		}
+
+		// The zone is not aligned to whole hours, so the UTC hour spans the
+		// end of one local hour and the start of the next
+		var shifted hourBits
+		fires := false
+		if s.firesInHour(local) {
+			for sec := offset; sec < 3600; sec++ {
+				if patterns[i].has(sec) {
+					shifted.set(sec - offset)
+					fires = true
+				}
+			}
+		}
+		split := 3600 - offset
+		if s.firesInHour(hour.Add(time.Duration(split) * time.Second).In(s.location)) {
+			for sec := 0; sec < offset; sec++ {
+				if patterns[i].has(sec) {
+					shifted.set(split + sec)
+					fires = true
+				}
+			}
+		}
+		if fires {
+			active = append(active, activeHour{index: i, bits: &shifted})
+		}
+	}
+	return active
+}
+
+// firesInHour reports whether the month, day and hour fields match t
+func (s *Scheduler) firesInHour(t time.Time) bool {
+	return s.expr.Month.Contains(int(t.Month())) && s.expr.Hour.Contains(t.Hour()) && s.matchesDay(t)
+}
+
+// overlapScan groups occurrences into overlaps as hours are scanned in order
+type overlapScan struct {
+	finder    *overlapFinder
+	report    *OverlapReport
+	tolerance int64
+	from, to  time.Time
+
+	open       bool
+	start, end int64 // Unix seconds of the first and last occurrence
+	members    map[int]bool
+	count      int
+}
+
+// hour feeds the occurrences of the active schedulers in one hour
+func (sc *overlapScan) hour(hour time.Time, active []activeHour) {
+	base := hour.Unix()
+
+	for w := 0; w < len(hourBits{}); w++ {
+		var union, twos uint64
+		for _, a := range active {
+			twos |= union & a.bits[w]
+			union |= a.bits[w]
+		}
+		// Without a tolerance only seconds where two schedulers fire matter
+		mask := union
+		if sc.tolerance == 0 {
+			mask = twos
+		}
+
+		for mask != 0 {
+			bit := bits.TrailingZeros64(mask)
+			mask &^= 1 << bit
+
+			sec := w*64 + bit
+			t := base + int64(sec)
+			if t < sc.from.Unix() || t >= sc.to.Unix() {
+				continue
+			}
+			for _, a := range active {
+				if a.bits.has(sec) {
+					sc.add(t, a.index)
+				}
+			}
+		}
+	}
+}
+
+// add records that scheduler index fires at Unix second t
+func (sc *overlapScan) add(t int64, index int) {
+	if sc.open && t-sc.end > sc.tolerance {
+		sc.close()
+	}
+	if !sc.open {
+		sc.open = true
+		sc.start = t
+		sc.members = make(map[int]bool)
+		sc.count = 0
+	}
+	sc.end = t
+	sc.members[index] = true
+	sc.count++
+}
+
+// close ends the current group, recording it if several schedulers fired
+func (sc *overlapScan) close() {
+	if !sc.open {
+		return
+	}
+	sc.open = false
+	if len(sc.members) < 2 {
+		return
+	}
+
+	members := make([]int, 0, len(sc.members))
+	for i := range sc.members {
+		members = append(members, i)
+	}
+	sort.Ints(members)
+
+	sc.report.Total++
+	for i := range members {
+		for j := i + 1; j < len(members); j++ {
+			sc.report.Pairs[[2]int{members[i], members[j]}]++
+		}
+	}
+	if len(sc.report.Overlaps) < sc.finder.limit {
+		sc.report.Overlaps = append(sc.report.Overlaps, Overlap{
+			Start:       time.Unix(sc.start, 0).In(sc.from.Location()),
+			End:         time.Unix(sc.end, 0).In(sc.from.Location()),
+			Schedulers:  members,
+			Occurrences: sc.count,
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// overlap_test.go - Tests for overlap detection across schedules
+
+package expressparser
+
+import (
+	"testing"
+	"time"
+)
+
+func overlapSchedulers(t *testing.T, specs ...[2]string) []*Scheduler {
+	t.Helper()
+	schedulers := make([]*Scheduler, len(specs))
+	for i, spec := range specs {
+		loc, err := time.LoadLocation(spec[1])
+		if err != nil {
+			t.Fatalf("LoadLocation(%q): %v", spec[1], err)
+		}
+		schedulers[i] = NewScheduler(MustParse(spec[0]), WithLocation(loc))
+	}
+	return schedulers
+}
+
+func TestFindOverlaps_SameInstant(t *testing.T) {
+	s := overlapSchedulers(t, [2]string{"0 9 * * *", "UTC"}, [2]string{"0 9 * * 1-5", "UTC"})
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) // Monday
+	report := FindOverlaps(s, from, from.AddDate(0, 0, 7))
+
+	if report.Total != 5 || len(report.Overlaps) != 5 {
+		t.Fatalf("Total = %d, len = %d, want 5", report.Total, len(report.Overlaps))
+	}
+	if got := report.Pairs[[2]int{0, 1}]; got != 5 {
+		t.Errorf("Pairs[0,1] = %d, want 5", got)
+	}
+
+	o := report.Overlaps[0]
+	want := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	if !o.Start.Equal(want) || !o.End.Equal(want) {
+		t.Errorf("first overlap = %v-%v, want %v", o.Start, o.End, want)
+	}
+	if o.Occurrences != 2 || len(o.Schedulers) != 2 {
+		t.Errorf("overlap = %+v", o)
+	}
+}
+
+func TestFindOverlaps_FieldIntersection(t *testing.T) {
+	s := overlapSchedulers(t,
+		[2]string{"*/15 * * * *", "UTC"},
+		[2]string{"*/10 * * * *", "UTC"},
+		[2]string{"0 */6 * * *", "UTC"},
+	)
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	report := FindOverlaps(s, from, from.AddDate(0, 0, 1))
+
+	// :00 and :30 of every hour
+	if report.Total != 48 {
+		t.Errorf("Total = %d, want 48", report.Total)
+	}
+	if got := report.Pairs[[2]int{0, 1}]; got != 48 {
+		t.Errorf("Pairs[0,1] = %d, want 48", got)
+	}
+	if got := report.Pairs[[2]int{0, 2}]; got != 4 {
+		t.Errorf("Pairs[0,2] = %d, want 4", got)
+	}
+	for _, o := range report.Overlaps {
+		if o.Start.Hour()%6 == 0 && o.Start.Minute() == 0 && len(o.Schedulers) != 3 {
+			t.Errorf("overlap at %v has schedulers %v, want all three", o.Start, o.Schedulers)
+		}
+	}
+}
+
+func TestFindOverlaps_Tolerance(t *testing.T) {
+	s := overlapSchedulers(t, [2]string{"0 9 * * *", "UTC"}, [2]string{"2 9 * * *", "UTC"})
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if report := FindOverlaps(s, from, from.AddDate(0, 0, 3)); report.Total != 0 {
+		t.Errorf("without tolerance Total = %d, want 0", report.Total)
+	}
+
+	report := FindOverlaps(s, from, from.AddDate(0, 0, 3), WithTolerance(5*time.Minute))
+	if report.Total != 3 {
+		t.Fatalf("Total = %d, want 3", report.Total)
+	}
+	o := report.Overlaps[0]
+	if o.Start.Minute() != 0 || o.End.Minute() != 2 || o.Occurrences != 2 {
+		t.Errorf("overlap = %+v, want 9:00-9:02 with 2 occurrences", o)
+	}
+}
+
+func TestFindOverlaps_ToleranceAcrossHours(t *testing.T) {
+	s := overlapSchedulers(t, [2]string{"59 8 * * *", "UTC"}, [2]string{"0 9 * * *", "UTC"})
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	report := FindOverlaps(s, from, from.AddDate(0, 0, 1), WithTolerance(time.Minute))
+	if report.Total != 1 {
+		t.Fatalf("Total = %d, want 1", report.Total)
+	}
+	if o := report.Overlaps[0]; o.Start.Hour() != 8 || o.End.Hour() != 9 {
+		t.Errorf("overlap = %v-%v, want 8:59-9:00", o.Start, o.End)
+	}
+}
+
+func TestFindOverlaps_Timezones(t *testing.T) {
+	s := overlapSchedulers(t,
+		[2]string{"0 9 * * *", "America/New_York"},
+		[2]string{"0 14 * * *", "UTC"},
+		[2]string{"30 19 * * *", "Asia/Kolkata"},
+	)
+	from := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	report := FindOverlaps(s, from, from.AddDate(0, 0, 1))
+
+	if report.Total != 1 {
+		t.Fatalf("Total = %d, want 1", report.Total)
+	}
+	o := report.Overlaps[0]
+	if !o.Start.Equal(time.Date(2024, 1, 10, 14, 0, 0, 0, time.UTC)) || len(o.Schedulers) != 3 {
+		t.Errorf("overlap = %+v", o)
+	}
+
+	// After the DST change New York runs at 13:00 UTC
+	from = time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+	report = FindOverlaps(s, from, from.AddDate(0, 0, 1))
+	if report.Total != 1 || len(report.Overlaps[0].Schedulers) != 2 {
+		t.Errorf("report = %+v, want UTC and Kolkata only", report)
+	}
+}
+
+func TestFindOverlaps_MatchesEnumeration(t *testing.T) {
+	s := overlapSchedulers(t,
+		[2]string{"*/7 8-18 * * 1-5", "Europe/Berlin"},
+		[2]string{"0,21,42 */2 * * *", "UTC"},
+		[2]string{"*/30 * L * *", "Asia/Kolkata"},
+	)
+	from := time.Date(2024, 1, 28, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	counts := make(map[int64]int)
+	for _, sc := range s {
+		for t0 := from.Add(-time.Second); ; {
+			next, err := sc.Next(t0)
+			if err != nil || !next.Before(to) {
+				break
+			}
+			counts[next.Unix()]++
+			t0 = next
+		}
+	}
+	want := 0
+	for _, n := range counts {
+		if n >= 2 {
+			want++
+		}
+	}
+
+	if want == 0 {
+		t.Fatal("enumeration found no overlaps")
+	}
+	if report := FindOverlaps(s, from, to); report.Total != want {
+		t.Errorf("Total = %d, want %d from enumeration", report.Total, want)
+	}
+}
+
+func TestFindOverlaps_Limit(t *testing.T) {
+	s := overlapSchedulers(t, [2]string{"* * * * *", "UTC"}, [2]string{"*/2 * * * *", "UTC"})
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	report := FindOverlaps(s, from, from.Add(time.Hour), WithOverlapLimit(5))
+
+	if report.Total != 30 || len(report.Overlaps) != 5 {
+		t.Errorf("Total = %d, len = %d, want 30 and 5", report.Total, len(report.Overlaps))
+	}
+}
@@ -45,6 +45,35 @@ func ParseWithSeconds(expr string) (*Expression, error) {
 	return parseCron(expr, WithSeconds())
 }
 
+// ParseWithOptions parses a cron expression with the given parser options
+//
+// Example:
+//
+//	expr, err := expressparser.ParseWithOptions("61 25 * * *", expressparser.WithAllErrors())
+func ParseWithOptions(expr string, opts ...ParserOption) (*Expression, error) {
+	return parseCron(expr, opts...)
+}
+
+// ParseAll parses a cron expression, reporting every invalid field and list
+// element instead of only the first
+//
+// Field problems are returned as a *ParseErrors; iterate its Errors or use
+// errors.As to inspect them. Empty expressions, a wrong number of fields and
+// unknown predefined expressions are still reported on their own.
+//
+// Example:
+//
+//	_, err := expressparser.ParseAll("61 25 32 13 8")
+//	var all *expressparser.ParseErrors
+//	if errors.As(err, &all) {
+//	    for _, e := range all.Errors {
+//	        fmt.Println(e) // one line per invalid field
+//	    }
+//	}
+func ParseAll(expr string) (*Expression, error) {
+	return parseCron(expr, WithAllErrors())
+}
+
 // MustParse parses a cron expression and panics if it fails
 //
 // Use this for known-good expressions, typically defined as constants.
@@ -318,13 +347,24 @@ func (s *Schedule) IsNow() bool {
 	return s.scheduler.IsNow()
 }
 
-// Describe returns a human-readable description
+// Describe returns a human-readable description, naming the schedule's
+// timezone: "At 9:00 AM, on weekdays (America/New_York)"
 func (s *Schedule) Describe() string {
-	return Describe(s.expression)
+	return s.DescribeWithOptions(DefaultDescriptionOptions())
 }
 
-// DescribeWithOptions returns a human-readable description with custom options
+// DescribeWithOptions returns a human-readable description with custom
+// options; the schedule's timezone is used if opts.Location is nil
+//
+// Example:
+//
+//	schedule.DescribeWithOptions(expressparser.DescriptionOptions{
+//	    ViewerLocation: time.Local, // also show times in the viewer's zone
+//	})
 func (s *Schedule) DescribeWithOptions(opts DescriptionOptions) string {
+	if opts.Location == nil {
+		opts.Location = s.Timezone()
+	}
 	return DescribeWithOptions(s.expression, opts)
 }
 
@@ -0,0 +1,156 @@
+// relative.go - Descriptions of runs relative to the current time
+
+package expressparser
+
+import (
+	"time"
+)
+
+// DescribeNext describes the next run after now relative to now, e.g.
+// "next run in 3 hours 12 minutes (Tue 9:00 AM)"
+//
+// The run time is shown in the schedule's timezone, or in opts'
+// ViewerLocation if set, using its Locale and Use24HourTime.
+//
+// Example:
+//
+//	desc, err := schedule.DescribeNext(time.Now(), expressparser.DescriptionOptions{
+//	    Use24HourTime: true,
+//	})
+//	// "next run in 3 hours 12 minutes (Tue 09:00)"
+func (s *Schedule) DescribeNext(now time.Time, opts ...DescriptionOptions) (string, error) {
+	next, err := s.Next(now)
+	if err != nil {
+		return "", err
+	}
+	return s.describeRun(PhraseNextRun, now, next, opts), nil
+}
+
+// DescribeLast describes the last run before now relative to now, e.g.
+// "last ran 2 days ago (Sun 9:00 AM)"
+//
+// The run time is shown as for DescribeNext.
+func (s *Schedule) DescribeLast(now time.Time, opts ...DescriptionOptions) (string, error) {
+	prev, err := s.Previous(now)
+	if err != nil {
+		return "", err
+	}
+	return s.describeRun(PhraseLastRun, now, prev, opts), nil
+}
+
+func (s *Schedule) describeRun(id Phrase, now, run time.Time, opts []DescriptionOptions) string {
+	options := DefaultDescriptionOptions()
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	l := resolveLocale(options.Locale)
+
+	loc := s.Timezone()
+	if options.ViewerLocation != nil {
+		loc = options.ViewerLocation
+	}
+	run = run.In(loc)
+
+	return l.phrase(id, l.runDuration(run.Sub(now)), l.dateTime(run, now.In(loc), options.Use24HourTime))
+}
+
+// HumanizeDuration formats d in its two largest units, e.g. "3 hours 12
+// minutes" or "2 days"
+//
+// The duration is truncated to whole seconds and its sign is ignored. opts
+// selects the language, as for Describe.
+func HumanizeDuration(d time.Duration, opts ...DescriptionOptions) string {
+	options := DefaultDescriptionOptions()
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	return resolveLocale(options.Locale).duration(d)
+}
+
+// durationUnits are the units of humanised durations, largest first
+var durationUnits = []struct {
+	size      time.Duration
+	one, many Phrase
+}{
+	{24 * time.Hour, PhraseDurationDay, PhraseDurationDays},
+	{time.Hour, PhraseDurationHour, PhraseDurationHours},
+	{time.Minute, PhraseDurationMinute, PhraseDurationMinutes},
+	{time.Second, PhraseDurationSecond, PhraseDurationSeconds},
+}
+
+// duration formats d in its largest unit and the next smaller one, leaving
+// out the smaller unit when it is zero
+func (l *Locale) duration(d time.Duration) string {
+	return l.durationWithDays(d, PhraseDurationDays)
+}
+
+// runDuration formats d as duration does, with the days inflected for
+// PhraseNextRun and PhraseLastRun if the locale has PhraseDurationDaysRun,
+// as German has "in 3 Tagen" but "3 Tage"
+func (l *Locale) runDuration(d time.Duration) string {
+	if _, ok := l.Phrases[PhraseDurationDaysRun]; ok {
+		return l.durationWithDays(d, PhraseDurationDaysRun)
+	}
+	return l.duration(d)
+}
+
+// durationWithDays formats d using the days phrase for more than one day
+func (l *Locale) durationWithDays(d time.Duration, days Phrase) string {
+	if d < 0 {
+		d = -d
+	}
+
+	var parts []string
+	for i, u := range durationUnits {
+		n := int(d / u.size)
+		d -= time.Duration(n) * u.size
+		if n == 0 {
+			if len(parts) > 0 {
+				break
+			}
+			continue
+		}
+		many := u.many
+		if many == PhraseDurationDays {
+			many = days
+		}
+		parts = append(parts, l.count(n, u.one, many))
+		if len(parts) == 2 || i == len(durationUnits)-1 {
+			break
+		}
+	}
+
+	switch len(parts) {
+	case 0:
+		return l.count(0, PhraseDurationSecond, PhraseDurationSeconds)
+	case 1:
+		return parts[0]
+	}
+	return l.phrase(PhraseDurationPair, parts[0], parts[1])
+}
+
+// count formats n with the singular or plural phrase
+func (l *Locale) count(n int, one, many Phrase) string {
+	if n == 1 {
+		return l.phrase(one, n)
+	}
+	return l.phrase(many, n)
+}
+
+// dateTime formats t with its short day name, adding the date when t is a
+// week or more away from now and the year when it is a year or more away
+func (l *Locale) dateTime(t, now time.Time, use24h bool) string {
+	clock := l.formatTime(t.Hour(), t.Minute(), use24h)
+	if t.Second() != 0 {
+		clock = l.formatTimeSeconds(t.Hour(), t.Minute(), t.Second(), use24h)
+	}
+
+	weekday := l.weekdayShort(int(t.Weekday()))
+	if days := civilDay(t) - civilDay(now); days > -7 && days < 7 {
+		return l.phrase(PhraseWeekdayTime, weekday, clock)
+	}
+	if !t.Before(now.AddDate(1, 0, 0)) || !t.After(now.AddDate(-1, 0, 0)) {
+		return l.phrase(PhraseDateTimeYear, weekday, t.Day(), l.month(int(t.Month())), t.Year(), clock)
+	}
+	return l.phrase(PhraseDateTime, weekday, t.Day(), l.month(int(t.Month())), clock)
+}
@@ -0,0 +1,138 @@
+// relative_test.go - Tests for relative run descriptions
+
+package expressparser
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		d      time.Duration
+		locale string
+		want   string
+	}{
+		{3*time.Hour + 12*time.Minute + 40*time.Second, "", "3 hours 12 minutes"},
+		{48 * time.Hour, "", "2 days"},
+		{49*time.Hour + 30*time.Minute, "", "2 days 1 hour"},
+		{24*time.Hour + 5*time.Minute, "", "1 day"},
+		{time.Minute, "", "1 minute"},
+		{45 * time.Second, "", "45 seconds"},
+		{-90 * time.Second, "", "1 minute 30 seconds"},
+		{500 * time.Millisecond, "", "0 seconds"},
+		{3*time.Hour + 12*time.Minute, "de", "3 Stunden 12 Minuten"},
+		{50 * time.Hour, "de", "2 Tage 2 Stunden"},
+		{48 * time.Hour, "fr", "2 jours"},
+		{time.Hour + time.Minute, "es", "1 hora 1 minuto"},
+		{3*time.Hour + 12*time.Minute, "ja", "3時間12分"},
+	}
+
+	for _, tt := range tests {
+		if got := HumanizeDuration(tt.d, DescriptionOptions{Locale: tt.locale}); got != tt.want {
+			t.Errorf("HumanizeDuration(%v, %q) = %q, want %q", tt.d, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestSchedule_DescribeNextAndLast(t *testing.T) {
+	schedule, err := NewScheduleInTimezone("0 9 * * 2,5", "America/New_York")
+	if err != nil {
+		t.Fatalf("NewScheduleInTimezone error: %v", err)
+	}
+	ny := schedule.Timezone()
+
+	// Tuesday 5:48 AM in New York
+	now := time.Date(2024, time.January, 16, 5, 48, 0, 0, ny)
+
+	tests := []struct {
+		name string
+		last bool
+		opts []DescriptionOptions
+		want string
+	}{
+		{"next", false, nil, "next run in 3 hours 12 minutes (Tue 9:00 AM)"},
+		{"next 24h", false, []DescriptionOptions{{Use24HourTime: true}}, "next run in 3 hours 12 minutes (Tue 09:00)"},
+		{"last", true, nil, "last ran 3 days 20 hours ago (Fri 9:00 AM)"},
+		{"next de", false, []DescriptionOptions{{Locale: "de"}}, "nächste Ausführung in 3 Stunden 12 Minuten (Di 09:00)"},
+		{"last de", true, []DescriptionOptions{{Locale: "de"}}, "zuletzt ausgeführt vor 3 Tagen 20 Stunden (Fr 09:00)"},
+		{"last ja", true, []DescriptionOptions{{Locale: "ja"}}, "前回実行は3日20時間前（(金) 09:00）"},
+		{"viewer", false, []DescriptionOptions{{ViewerLocation: time.UTC, Use24HourTime: true}}, "next run in 3 hours 12 minutes (Tue 14:00)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			describe := schedule.DescribeNext
+			if tt.last {
+				describe = schedule.DescribeLast
+			}
+			got, err := describe(now, tt.opts...)
+			if err != nil {
+				t.Fatalf("error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchedule_DescribeNextFarAway(t *testing.T) {
+	schedule, err := NewSchedule("0 0 1 1 *")
+	if err != nil {
+		t.Fatalf("NewSchedule error: %v", err)
+	}
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	got, err := schedule.DescribeNext(now)
+	if err != nil {
+		t.Fatalf("DescribeNext error: %v", err)
+	}
+	if want := "next run in 296 days 12 hours (Wed January 1 12:00 AM)"; got != want {
+		t.Errorf("DescribeNext() = %q, want %q", got, want)
+	}
+
+	got, err = schedule.DescribeLast(now, DescriptionOptions{Locale: "fr"})
+	if err != nil {
+		t.Fatalf("DescribeLast error: %v", err)
+	}
+	if want := "dernière exécution il y a 69 jours 12 heures (lun. 1 janvier 00:00)"; got != want {
+		t.Errorf("DescribeLast() = %q, want %q", got, want)
+	}
+}
+
+func TestSchedule_DescribeNextYearsAway(t *testing.T) {
+	schedule, err := NewSchedule("0 0 29 2 *")
+	if err != nil {
+		t.Fatalf("NewSchedule error: %v", err)
+	}
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"en", "next run in 1450 days 12 hours (Tue February 29, 2028 12:00 AM)"},
+		{"de", "nächste Ausführung in 1450 Tagen 12 Stunden (Di 29. Februar 2028 00:00)"},
+		{"ja", "次回実行まで1450日12時間（2028年2月29日(火) 00:00）"},
+	}
+
+	for _, tt := range tests {
+		got, err := schedule.DescribeNext(now, DescriptionOptions{Locale: tt.locale})
+		if err != nil {
+			t.Fatalf("DescribeNext error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("DescribeNext(%s) = %q, want %q", tt.locale, got, tt.want)
+		}
+	}
+
+	// The last run was less than a year ago, so its year is left out
+	got, err := schedule.DescribeLast(now)
+	if err != nil {
+		t.Fatalf("DescribeLast error: %v", err)
+	}
+	if want := "last ran 10 days 12 hours ago (Thu February 29 12:00 AM)"; got != want {
+		t.Errorf("DescribeLast() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,235 @@
+// runner.go - Minimal job runner built on Schedule
+
+package expressparser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Job is a unit of work executed by a Runner on every occurrence of its schedule
+type Job func(ctx context.Context) error
+
+// Runner executes jobs at the times produced by their schedules
+//
+// Each job runs in its own goroutine and never overlaps with itself: if a run
+// takes longer than the gap to the next occurrence, the occurrences that were
+// missed in the meantime are skipped.
+type Runner struct {
+	mu      sync.Mutex
+	entries []*runnerEntry
+	ids     map[string]bool
+	running bool
+
+	locker    Locker
+	chain     []JobWrapper
+	listeners []EventListener
+	now       func() time.Time
+}
+
+// runnerEntry is a job registered with a Runner
+type runnerEntry struct {
+	id       string
+	schedule *Schedule
+	job      Job
+}
+
+// RunnerOption configures a Runner
+type RunnerOption func(*Runner)
+
+// WithLocker makes the runner claim every occurrence through l before
+// executing it, so that only one of several replicas runs each occurrence
+func WithLocker(l Locker) RunnerOption {
+	return func(r *Runner) {
+		r.locker = l
+	}
+}
+
+// WithChain wraps every job added to the runner with the given wrappers,
+// composed as by Chain
+func WithChain(wrappers ...JobWrapper) RunnerOption {
+	return func(r *Runner) {
+		r.chain = append(r.chain, wrappers...)
+	}
+}
+
+// WithEventListener registers listeners notified of every lifecycle event
+func WithEventListener(listeners ...EventListener) RunnerOption {
+	return func(r *Runner) {
+		r.listeners = append(r.listeners, listeners...)
+	}
+}
+
+// NewRunner creates a runner with no jobs
+func NewRunner(opts ...RunnerOption) *Runner {
+	r := &Runner{
+		ids: make(map[string]bool),
+		now: time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Add registers a job under a unique ID
+//
+// The ID identifies the job to the Locker, so replicas must register the same
+// job under the same ID. Jobs cannot be added once Run has been called.
+func (r *Runner) Add(id string, schedule *Schedule, job Job) error {
+	if schedule == nil || job == nil {
+		return errors.New("schedule and job cannot be nil")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.running {
+		return errors.New("cannot add jobs to a running runner")
+	}
+	if r.ids[id] {
+		return fmt.Errorf("job %q already registered", id)
+	}
+
+	r.ids[id] = true
+	r.entries = append(r.entries, &runnerEntry{id: id, schedule: schedule, job: Chain(r.chain...)(job)})
+	return nil
+}
+
+// Run executes the registered jobs until ctx is cancelled
+//
+// Run blocks until every job goroutine has returned, including runs that were
+// in progress when ctx was cancelled. It returns ctx.Err(). Once Run has
+// returned, jobs may be added and the runner run again.
+func (r *Runner) Run(ctx context.Context) error {
+	r.mu.Lock()
+	if r.running {
+		r.mu.Unlock()
+		return errors.New("runner is already running")
+	}
+	r.running = true
+	entries := append([]*runnerEntry(nil), r.entries...)
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		r.running = false
+		r.mu.Unlock()
+	}()
+
+	var wg sync.WaitGroup
+	for _, e := range entries {
+		wg.Add(1)
+		go func(e *runnerEntry) {
+			defer wg.Done()
+			r.loop(ctx, e)
+		}(e)
+	}
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// loop waits for each occurrence of one job and runs it
+func (r *Runner) loop(ctx context.Context, e *runnerEntry) {
+	scheduler := e.schedule.Scheduler()
+	from := r.now()
+
+	for {
+		next, err := scheduler.Next(from)
+		if err != nil {
+			ev := NewEvent(EventNoNextRun, scheduler, e.id, time.Time{})
+			ev.Err = err
+			r.emit(ev)
+			return
+		}
+		r.emit(NewEvent(EventScheduled, scheduler, e.id, next))
+
+		timer := time.NewTimer(next.Sub(r.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		r.runOccurrence(ctx, e, next)
+
+		from = r.skipOverrun(e, next, r.now())
+	}
+}
+
+// skipOverrun skips the occurrences after last that passed before now while
+// the job was running, and returns the time to schedule the next run from
+//
+// The skipped occurrences are reported in a single event, so a long overrun
+// of a frequent job does not flood listeners.
+func (r *Runner) skipOverrun(e *runnerEntry, last, now time.Time) time.Time {
+	scheduler := e.schedule.Scheduler()
+	from := last
+	var skipped Event
+	for {
+		missed, err := scheduler.Next(from)
+		if err != nil || !missed.Before(now) {
+			break
+		}
+		if skipped.Missed == 0 {
+			skipped = NewEvent(EventSkipped, scheduler, e.id, missed)
+			skipped.Reason = SkipOverrun
+		}
+		skipped.Missed++
+		from = missed
+	}
+	if skipped.Missed > 0 {
+		r.emit(skipped)
+	}
+	if now.After(from) {
+		from = now
+	}
+	return from
+}
+
+// runOccurrence runs one occurrence of a job, identified by its nominal time
+func (r *Runner) runOccurrence(ctx context.Context, e *runnerEntry, nominal time.Time) error {
+	scheduler := e.schedule.Scheduler()
+
+	if r.locker != nil {
+		release, ok := r.locker.TryLock(e.id, nominal)
+		if !ok {
+			ev := NewEvent(EventSkipped, scheduler, e.id, nominal)
+			ev.Reason = SkipLocked
+			ev.Missed = 1
+			r.emit(ev)
+			return nil
+		}
+		defer release()
+	}
+
+	ev := NewEvent(EventStarted, scheduler, e.id, nominal)
+	ev.Start = r.now()
+	r.emit(ev)
+
+	err := e.job(withJobInfo(ctx, JobInfo{ID: e.id, Scheduled: nominal}))
+
+	ev.Duration = r.now().Sub(ev.Start)
+	ev.Type = EventFinished
+	if err != nil {
+		ev.Type = EventFailed
+		ev.Err = err
+	}
+	r.emit(ev)
+
+	return err
+}
+
+// emit delivers ev to every registered listener
+func (r *Runner) emit(ev Event) {
+	for _, l := range r.listeners {
+		l.OnEvent(ev)
+	}
+}
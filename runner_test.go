@@ -0,0 +1,167 @@
+package expressparser
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func mustSchedule(t *testing.T, expr string) *Schedule {
+	t.Helper()
+	s, err := NewSchedule(expr)
+	if err != nil {
+		t.Fatalf("NewSchedule(%q) error = %v", expr, err)
+	}
+	return s
+}
+
+func TestRunner_Add(t *testing.T) {
+	r := NewRunner()
+	job := func(ctx context.Context) error { return nil }
+
+	if err := r.Add("job", mustSchedule(t, "* * * * *"), job); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := r.Add("job", mustSchedule(t, "* * * * *"), job); err == nil {
+		t.Errorf("Add() with duplicate ID error = nil, want error")
+	}
+	if err := r.Add("other", nil, job); err == nil {
+		t.Errorf("Add() with nil schedule error = nil, want error")
+	}
+}
+
+func TestRunner_LockerSingleExecution(t *testing.T) {
+	locker := NewMemoryLocker()
+	schedule := mustSchedule(t, "0 9 * * *")
+	nominal := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	var runs int32
+	job := func(ctx context.Context) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	}
+
+	// Three replicas reach the same occurrence
+	for i := 0; i < 3; i++ {
+		r := NewRunner(WithLocker(locker))
+		if err := r.Add("report", schedule, job); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		r.runOccurrence(context.Background(), r.entries[0], nominal)
+	}
+
+	if runs != 1 {
+		t.Errorf("job ran %d times, want 1", runs)
+	}
+}
+
+func TestRunner_Run(t *testing.T) {
+	r := NewRunner()
+
+	var runs int32
+	done := make(chan struct{})
+	err := r.Add("tick", mustSchedule(t, "* * * * * *"), func(ctx context.Context) error {
+		if atomic.AddInt32(&runs, 1) == 1 {
+			close(done)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error)
+	go func() { result <- r.Run(ctx) }()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("job did not run within 3s")
+	}
+	if err := r.Add("late", mustSchedule(t, "* * * * *"), func(ctx context.Context) error { return nil }); err == nil {
+		t.Errorf("Add() on running runner error = nil, want error")
+	}
+	cancel()
+
+	if err := <-result; err != context.Canceled {
+		t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRunner_RunAgain(t *testing.T) {
+	r := NewRunner()
+
+	runs := make(chan string, 16)
+	err := r.Add("first", mustSchedule(t, "* * * * * *"), func(ctx context.Context) error {
+		runs <- "first"
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	// run waits for a run of the job with the given ID, then stops the runner
+	run := func(id string) {
+		t.Helper()
+		ctx, cancel := context.WithCancel(context.Background())
+		result := make(chan error)
+		go func() { result <- r.Run(ctx) }()
+
+		timeout := time.After(3 * time.Second)
+		for got := ""; got != id; {
+			select {
+			case got = <-runs:
+			case <-timeout:
+				t.Fatalf("%s did not run within 3s", id)
+			}
+		}
+		cancel()
+		if err := <-result; err != context.Canceled {
+			t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+		}
+	}
+
+	run("first")
+	err = r.Add("second", mustSchedule(t, "* * * * * *"), func(ctx context.Context) error {
+		runs <- "second"
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Add() after Run returned error = %v", err)
+	}
+	run("second")
+}
+
+func TestRunner_SkipOverrun(t *testing.T) {
+	var events []Event
+	r := NewRunner(WithEventListener(EventListenerFunc(func(e Event) {
+		events = append(events, e)
+	})))
+	if err := r.Add("ticker", mustSchedule(t, "* * * * * *"), func(ctx context.Context) error { return nil }); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	last := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := last.Add(time.Hour)
+	from := r.skipOverrun(r.entries[0], last, now)
+
+	if !from.Equal(now) {
+		t.Errorf("skipOverrun() = %v, want %v", from, now)
+	}
+	if len(events) != 1 {
+		t.Fatalf("emitted %d events, want 1", len(events))
+	}
+	ev := events[0]
+	if ev.Type != EventSkipped || ev.Reason != SkipOverrun || ev.Missed != 3599 ||
+		!ev.Scheduled.Equal(last.Add(time.Second)) {
+		t.Errorf("event = %+v, want one overrun skip of 3599 occurrences from 9:00:01", ev)
+	}
+
+	events = nil
+	r.skipOverrun(r.entries[0], last, last.Add(500*time.Millisecond))
+	if len(events) != 0 {
+		t.Errorf("emitted %v without an overrun", events)
+	}
+}
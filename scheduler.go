@@ -289,7 +289,7 @@ func (s *Scheduler) matchesSpecialDay(t time.Time) bool {
 
 	// Check for "L-N" - Nth day before end of month
 	for v := range s.expr.DayOfMonth.Values {
-		if v > 32 && v < 100 {
+		if v > 32 && v < lastWeekdayValue {
 			offset := v - 32
 			targetDay := lastDay - offset
 			if targetDay > 0 && day == targetDay {
@@ -299,7 +299,7 @@ func (s *Scheduler) matchesSpecialDay(t time.Time) bool {
 	}
 
 	// Check for "LW" - last weekday of month
-	if s.expr.HasLastWeekday && s.expr.DayOfMonth.Values[33] {
+	if s.expr.HasLastWeekday && s.expr.DayOfMonth.Values[lastWeekdayValue] {
 		lastWeekday := s.lastWeekdayOfMonth(year, month)
 		if day == lastWeekday {
 			return true
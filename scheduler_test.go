@@ -46,6 +46,30 @@ func TestScheduler_Next_FirstOfMonth(t *testing.T) {
 	}
 }
 
+func TestScheduler_Next_DaysBeforeLastDay(t *testing.T) {
+	from := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"0 0 L-1 * *", time.Date(2026, 5, 30, 0, 0, 0, 0, time.UTC)},
+		{"0 0 L-3 * *", time.Date(2026, 5, 28, 0, 0, 0, 0, time.UTC)},
+		{"0 0 LW * *", time.Date(2026, 5, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 L-3,31 * *", time.Date(2026, 5, 28, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got, err := NewScheduler(mustParseExpr(t, tt.expr)).Next(from)
+		if err != nil {
+			t.Fatalf("%q Next() error = %v", tt.expr, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%q Next() = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
 func TestScheduler_Previous_SimpleDaily(t *testing.T) {
 	expr := mustParseExpr(t, "0 9 * * *") // 09:00 every day
 	s := NewScheduler(expr)
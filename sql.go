@@ -0,0 +1,87 @@
+// sql.go - database/sql support for expressions
+
+package expressparser
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing the expression as its cron string
+//
+// The zero Expression fails with ErrEmptyExpression rather than storing an
+// empty string; store NULL with NullExpression instead.
+func (e Expression) Value() (driver.Value, error) {
+	if e.Minute == nil {
+		return nil, fmt.Errorf("%w: cannot store the zero Expression; use NullExpression for NULL", ErrEmptyExpression)
+	}
+	text, err := e.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or []byte column value
+//
+// An invalid stored expression fails with the error Parse returns. NULL is
+// an error; scan nullable columns into a NullExpression.
+func (e *Expression) Scan(src any) error {
+	var text string
+	switch v := src.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	case nil:
+		return errors.New("cannot scan NULL into Expression; use NullExpression")
+	default:
+		return fmt.Errorf("cannot scan %T into Expression", src)
+	}
+
+	// Unlike UnmarshalText, an empty column is an error rather than unset
+	parsed, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	*e = *parsed
+	return nil
+}
+
+// NullExpression is an Expression that may be NULL, like sql.NullString
+//
+// Example:
+//
+//	var cron expressparser.NullExpression
+//	err := db.QueryRow("SELECT cron FROM jobs WHERE id = ?", id).Scan(&cron)
+//	if cron.Valid {
+//	    next, _ := expressparser.NewScheduler(&cron.Expression).Next(time.Now())
+//	}
+type NullExpression struct {
+	Expression Expression
+	Valid      bool // Valid is true if Expression is not NULL
+}
+
+// Value implements driver.Valuer, storing NULL when n is not valid. A valid
+// NullExpression holding the zero Expression fails as Expression.Value does.
+func (n NullExpression) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Expression.Value()
+}
+
+// Scan implements sql.Scanner, setting Valid to false for NULL
+func (n *NullExpression) Scan(src any) error {
+	if src == nil {
+		n.Expression, n.Valid = Expression{}, false
+		return nil
+	}
+	if err := n.Expression.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,79 @@
+// sql_test.go - Tests for database/sql support
+
+package expressparser
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*Expression)(nil)
+	_ driver.Valuer = Expression{}
+	_ sql.Scanner   = (*NullExpression)(nil)
+	_ driver.Valuer = NullExpression{}
+)
+
+func TestExpression_Scan(t *testing.T) {
+	for _, src := range []any{"0 9 * * 1-5", []byte("0 9 * * 1-5")} {
+		var e Expression
+		if err := e.Scan(src); err != nil {
+			t.Fatalf("Scan(%T) error: %v", src, err)
+		}
+		if e.String() != "0 9 * * 1-5" {
+			t.Errorf("Scan(%T) = %q", src, e.String())
+		}
+	}
+
+	var e Expression
+	err := e.Scan("0 9 * 13 *")
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != FieldMonth {
+		t.Errorf("Scan error = %v, want month *FieldError", err)
+	}
+	if err := e.Scan(nil); err == nil {
+		t.Error("expected error scanning NULL")
+	}
+	if err := e.Scan(42); err == nil {
+		t.Error("expected error scanning int")
+	}
+}
+
+func TestExpression_Value(t *testing.T) {
+	v, err := MustParse("@hourly").Value()
+	if err != nil || v != "0 * * * *" {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+
+	if v, err := (Expression{}).Value(); !errors.Is(err, ErrEmptyExpression) || v != nil {
+		t.Errorf("zero Value() = %v, %v, want ErrEmptyExpression", v, err)
+	}
+}
+
+func TestNullExpression(t *testing.T) {
+	var n NullExpression
+	if err := n.Scan("*/5 * * * *"); err != nil || !n.Valid || n.Expression.String() != "*/5 * * * *" {
+		t.Fatalf("Scan = %+v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != "*/5 * * * *" {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Fatalf("Scan(nil) = %+v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("Value() = %v, %v, want nil", v, err)
+	}
+
+	err := n.Scan("* * * *")
+	if !errors.Is(err, ErrInvalidFieldCount) || n.Valid {
+		t.Errorf("Scan error = %v, Valid = %v", err, n.Valid)
+	}
+
+	if v, err := (NullExpression{Valid: true}).Value(); !errors.Is(err, ErrEmptyExpression) || v != nil {
+		t.Errorf("valid zero Value() = %v, %v, want ErrEmptyExpression", v, err)
+	}
+}
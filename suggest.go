@@ -0,0 +1,77 @@
+// suggest.go - "Did you mean" suggestions for misspelt names
+
+package expressparser
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestionDistance is the largest edit distance still considered a typo
+const maxSuggestionDistance = 2
+
+// suggest returns the candidate closest to s by edit distance, or "" if none
+// is close enough to be a plausible typo. Ties go to the shorter candidate,
+// then alphabetically, so results are deterministic.
+func suggest[V any](s string, candidates map[string]V) string {
+	if s == "" || isDigits(s) {
+		return ""
+	}
+
+	names := make([]string, 0, len(candidates))
+	for name := range candidates {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) < len(names[j])
+		}
+		return names[i] < names[j]
+	})
+
+	best, bestDist := "", maxSuggestionDistance+1
+	upper := strings.ToUpper(s)
+	for _, name := range names {
+		if d := editDistance(upper, strings.ToUpper(name)); d < bestDist {
+			best, bestDist = name, d
+		}
+	}
+
+	// A short input is too ambiguous to correct by two edits
+	if bestDist == maxSuggestionDistance && len(s) <= 3 {
+		return ""
+	}
+	return best
+}
+
+// editDistance computes the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}